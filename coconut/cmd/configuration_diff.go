@@ -0,0 +1,48 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationDiffCmd = &cobra.Command{
+	Use:   "diff [file]",
+	Example: `coconut conf diff hacking/config.yaml
+coconut conf diff control.json --prefix o2/control`,
+	Short: "compare a YAML or JSON file with the configuration store",
+	Long: `The configuration diff command reads a YAML or JSON file and prints 
+the changes that importing it would make to O² Configuration, one line per 
+value, with - for removed or old values and + for added or new values.`,
+	Run:   configuration.WrapCall(configuration.Diff),
+	Args:  cobra.ExactArgs(1),
+}
+
+func init() {
+	configurationCmd.AddCommand(configurationDiffCmd)
+
+	configurationDiffCmd.Flags().StringP("prefix", "p", "", "configuration key to compare the file with")
+}
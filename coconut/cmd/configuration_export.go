@@ -0,0 +1,49 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationExportCmd = &cobra.Command{
+	Use:   "export [prefix]",
+	Aliases: []string{"e", "exp"},
+	Example: `coconut conf export o2/control
+coconut conf export o2/control --format json > control.json`,
+	Short: "export a configuration subtree to YAML or JSON",
+	Long: `The configuration export command requests from O² Configuration 
+the subtree at the given prefix, and writes it to standard output as YAML 
+or JSON, in a form suitable for the configuration import command.`,
+	Run:   configuration.WrapCall(configuration.Export),
+	Args:  cobra.ExactArgs(1),
+}
+
+func init() {
+	configurationCmd.AddCommand(configurationExportCmd)
+
+	configurationExportCmd.Flags().StringP("format", "f", "yaml", "output format for the configuration export (yaml/json)")
+}
@@ -0,0 +1,57 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/AliceO2Group/Control/coconut/configuration"
+	"github.com/spf13/cobra"
+)
+
+var configurationImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Aliases: []string{"i", "imp"},
+	Example: `coconut conf import hacking/config.yaml --dry-run
+coconut conf import hacking/config.yaml
+coconut conf import control.json --prefix o2/control
+coconut conf import hacking/config.yaml --config_endpoint consul://127.0.0.1:8500`,
+	Short: "import a YAML or JSON file into the configuration store",
+	Long: `The configuration import command reads a YAML or JSON file and writes 
+its contents to O² Configuration. 
+If a prefix is given, the whole file is written as the subtree at that prefix, 
+otherwise each top level key of the file is written as its own subtree. 
+Existing subtrees are replaced.
+
+The changes are printed before being applied, and with --dry-run nothing 
+is written to the configuration store.`,
+	Run:   configuration.WrapCall(configuration.Import),
+	Args:  cobra.ExactArgs(1),
+}
+
+func init() {
+	configurationCmd.AddCommand(configurationImportCmd)
+
+	configurationImportCmd.Flags().StringP("prefix", "p", "", "configuration key under which to import the file")
+	configurationImportCmd.Flags().BoolP("dry-run", "n", false, "print the changes without applying them")
+}
@@ -51,6 +51,10 @@ Examples:
  * ` + "`myworkflow@rev`" + ` - loads a workflow from default repository, on branch, tag or revision ` + "`rev`" + `
  * ` + "`coconut env create -w github.com/AliceO2Group/MyConfRepo/myworkflow@rev`" + ` - loads a workflow from a specific git repository, on branch, tag or revision ` + "`rev`" + `
 
+Variables can be passed to the workflow with the extra-vars flag, as a comma separated list of key=value pairs. They override the variables set in the workflow template and in the configuration, and they can be used for instance to enable or disable roles.
+Example:
+ * ` + "`coconut env create -w myworkflow -e qc_enabled=true,n_flps=4`" + `
+
 For more information on the %s workflow configuration system, see documentation for the ` + "`coconut repository`" + ` command.`, product.PRETTY_SHORTNAME, product.PRETTY_SHORTNAME),
 	Run:   control.WrapCall(control.CreateEnvironment),
 
@@ -61,4 +65,5 @@ func init() {
 
 	environmentCreateCmd.Flags().StringP("workflow-template", "w", "", "workflow to be loaded in the new environment")
 	environmentCreateCmd.MarkFlagRequired("workflow-template")
+	environmentCreateCmd.Flags().StringSliceP("extra-vars", "e", []string{}, "workflow variables as key=value pairs, separated by commas")
 }
@@ -0,0 +1,58 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/AliceO2Group/Control/common/product"
+	"github.com/spf13/cobra"
+)
+
+// environmentGraphCmd represents the environment graph command
+var environmentGraphCmd = &cobra.Command{
+	Use:   "graph [environment id]",
+	Aliases: []string{"topology", "gr"},
+	Short: "show the data flow topology of an environment",
+	Long: fmt.Sprintf(`The environment graph command requests from %s the
+tasks of an existing environment and the channels which connect them.
+
+Each task is a node with its role path, hostname, class and state, and each outbound channel
+is an edge with its name, type and resolved endpoint. The output is a Graphviz digraph
+by default, which can be rendered for instance with
+
+    coconut environment graph [environment id] | dot -Tsvg > topology.svg
+
+or JSON with --format json.`, product.PRETTY_SHORTNAME),
+	Run:   control.WrapCall(control.GraphEnvironment),
+	Args:  cobra.ExactArgs(1),
+}
+
+func init() {
+	environmentCmd.AddCommand(environmentGraphCmd)
+
+	environmentGraphCmd.Flags().StringP("format", "f", "dot", "output format for the topology (dot/json)")
+}
@@ -0,0 +1,50 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/AliceO2Group/Control/common/product"
+	"github.com/spf13/cobra"
+)
+
+// infoReloadCmd represents the info reload command
+var infoReloadCmd = &cobra.Command{
+	Use:   "reload",
+	Aliases: []string{"refresh", "r"},
+	Short: fmt.Sprintf("reload the configuration of the %s core instance", product.PRETTY_SHORTNAME),
+	Long: fmt.Sprintf(`The info reload command makes the running instance of %s core re-read
+its configuration and the list of repositories from the configuration backend,
+without restarting.
+The settings which changed are listed, along with whether the new value is
+already in effect or only applies after a restart of the core.`, product.PRETTY_SHORTNAME),
+	Run:   control.WrapCall(control.RefreshConfig),
+}
+
+func init() {
+	infoCmd.AddCommand(infoReloadCmd)
+}
@@ -0,0 +1,49 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/coconut/control"
+	"github.com/AliceO2Group/Control/common/product"
+	"github.com/spf13/cobra"
+)
+
+// runConfigCmd represents the run config command
+var runConfigCmd = &cobra.Command{
+	Use:   "config [run number]",
+	Aliases: []string{"configuration", "conf", "c"},
+	Short: "show the configuration pushed to the tasks of a run",
+	Long: fmt.Sprintf(`The run config command requests from %s the configuration 
+which was recorded for a run when it started, i.e. the workflow template revision 
+and the properties which each task received with its last CONFIGURE transition.`, product.PRETTY_SHORTNAME),
+	Run:   control.WrapCall(control.GetRunConfiguration),
+	Args:  cobra.ExactArgs(1),
+}
+
+func init() {
+	runCmd.AddCommand(runConfigCmd)
+}
@@ -0,0 +1,50 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/AliceO2Group/Control/coconut/control"
+)
+
+// templateLintCmd represents the template lint command
+var templateLintCmd = &cobra.Command{
+	Use:   "lint [workflow template]",
+	Short: "check a workflow template for problems",
+	Long: `The template lint command loads a workflow template without acquiring any task,
+and reports unknown task classes, unresolved {{ }} fields and outbound channels whose
+target does not match any inbound channel in the workflow.
+
+Variables can be passed to the workflow with the extra-vars flag, as a comma separated list of key=value pairs, just like when creating an environment.
+The command fails if any problem is found.`,
+	Args:  cobra.ExactArgs(1),
+	Run:   control.WrapCall(control.LintWorkflowTemplate),
+}
+
+func init() {
+	templateCmd.AddCommand(templateLintCmd)
+
+	templateLintCmd.Flags().StringSliceP("extra-vars", "e", []string{}, "workflow variables as key=value pairs, separated by commas")
+}
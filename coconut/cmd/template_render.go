@@ -0,0 +1,50 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2018 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/AliceO2Group/Control/coconut/control"
+)
+
+// templateRenderCmd represents the template render command
+var templateRenderCmd = &cobra.Command{
+	Use:   "render [workflow template]",
+	Short: "render a workflow template without deploying it",
+	Long: `The template render command loads a workflow template without acquiring any task,
+and shows the resulting role tree, with iterators and includes expanded, the task class
+of each task role and the targets of its outbound channels.
+
+Variables can be passed to the workflow with the extra-vars flag, as a comma separated list of key=value pairs, just like when creating an environment.
+Any problem found in the workflow template is reported after the tree.`,
+	Args:  cobra.ExactArgs(1),
+	Run:   control.WrapCall(control.RenderWorkflowTemplate),
+}
+
+func init() {
+	templateCmd.AddCommand(templateRenderCmd)
+
+	templateRenderCmd.Flags().StringSliceP("extra-vars", "e", []string{}, "workflow variables as key=value pairs, separated by commas")
+}
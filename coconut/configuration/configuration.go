@@ -42,6 +42,8 @@ import (
 	"encoding/json"
 	"gopkg.in/yaml.v2"
 	"github.com/naoina/toml"
+	"io/ioutil"
+	"sort"
 )
 
 var log = logger.New(logrus.StandardLogger(), "coconut")
@@ -221,4 +223,266 @@ func isInputNameValid(input string)(valid bool) {
 	} else {
 		return true
 	}
-}
\ No newline at end of file
+}
+
+func Export(cfg configuration.Source, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+	key := args[0]
+
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return
+	}
+
+	data, err := getSubtree(cfg, key)
+	if err != nil {
+		return
+	}
+	if data == nil {
+		err = errors.New(fmt.Sprintf("no configuration found at %s", key))
+		return
+	}
+
+	var output []byte
+	switch strings.ToLower(format) {
+	case "json":
+		output, err = json.MarshalIndent(data, "", "    ")
+	case "yaml":
+		output, err = yaml.Marshal(data)
+	default:
+		err = errors.New(fmt.Sprintf("unsupported export format %s, must be yaml or json", format))
+		return
+	}
+	if err != nil {
+		err = errors.New(fmt.Sprintf("cannot serialize subtree to %s: %s", strings.ToLower(format), err.Error()))
+		return
+	}
+
+	fmt.Fprintln(o, string(output))
+	return nil
+}
+
+func Import(cfg configuration.Source, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	prefix, err := cmd.Flags().GetString("prefix")
+	if err != nil {
+		return
+	}
+	dryRun, err := cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return
+	}
+
+	subtrees, err := readSubtrees(args[0], prefix)
+	if err != nil {
+		return
+	}
+
+	changes, err := diffSubtrees(cfg, subtrees)
+	if err != nil {
+		return
+	}
+	printChanges(o, changes)
+
+	if dryRun {
+		fmt.Fprintln(o, "dry run, no changes applied")
+		return
+	}
+	if len(changes) == 0 {
+		return
+	}
+
+	for _, key := range sortedKeys(subtrees) {
+		err = cfg.PutRecursive(key, subtrees[key])
+		if err != nil {
+			err = errors.New(fmt.Sprintf("cannot import configuration subtree %s: %s", key, err.Error()))
+			return
+		}
+	}
+	fmt.Fprintf(o, "%d change(s) applied to %s\n", len(changes), viper.GetString("config_endpoint"))
+	return
+}
+
+func Diff(cfg configuration.Source, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	prefix, err := cmd.Flags().GetString("prefix")
+	if err != nil {
+		return
+	}
+
+	subtrees, err := readSubtrees(args[0], prefix)
+	if err != nil {
+		return
+	}
+
+	changes, err := diffSubtrees(cfg, subtrees)
+	if err != nil {
+		return
+	}
+	printChanges(o, changes)
+	return
+}
+
+type change struct {
+	key      string
+	oldValue *string
+	newValue *string
+}
+
+// readSubtrees parses a YAML or JSON configuration file and returns the subtrees it
+// should replace in the configuration store, keyed by path. If prefix is empty, each
+// top level key of the file is its own subtree.
+func readSubtrees(path string, prefix string) (subtrees map[string]configuration.Item, err error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+
+	item, err := configuration.ItemFromYaml(data)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("cannot parse configuration file %s: %s", path, err.Error()))
+		return
+	}
+
+	subtrees = make(map[string]configuration.Item)
+	prefix = strings.Trim(prefix, "/")
+	if len(prefix) != 0 {
+		subtrees[prefix] = item
+		return
+	}
+
+	if item.Type() != configuration.IT_Map {
+		err = errors.New(fmt.Sprintf("configuration file %s must contain a map when no prefix is given", path))
+		return
+	}
+	for k, v := range item.Map() {
+		subtrees[k] = v
+	}
+	return
+}
+
+// getSubtree returns the configuration subtree at key, or nil if there is none.
+func getSubtree(cfg configuration.Source, key string) (item configuration.Item, err error) {
+	item, err = cfg.GetRecursive(key)
+	if err != nil {
+		exists, existsErr := cfg.Exists(key)
+		if existsErr == nil && !exists {
+			return nil, nil
+		}
+		return
+	}
+	// Some sources return an empty map rather than an error for a missing key
+	if item != nil && item.Type() == configuration.IT_Map && len(item.Map()) == 0 {
+		return nil, nil
+	}
+	return
+}
+
+// diffSubtrees compares the given subtrees with the contents of the configuration store
+// and returns the list of leaf-level changes, sorted by key.
+func diffSubtrees(cfg configuration.Source, subtrees map[string]configuration.Item) (changes []change, err error) {
+	changes = make([]change, 0)
+	for _, key := range sortedKeys(subtrees) {
+		var current configuration.Item
+		current, err = getSubtree(cfg, key)
+		if err != nil {
+			err = errors.New(fmt.Sprintf("cannot get configuration subtree %s: %s", key, err.Error()))
+			return
+		}
+
+		oldLeaves := make(map[string]string)
+		flattenItem(key, current, oldLeaves)
+		newLeaves := make(map[string]string)
+		flattenItem(key, subtrees[key], newLeaves)
+
+		allKeys := make([]string, 0, len(oldLeaves) + len(newLeaves))
+		for k := range oldLeaves {
+			allKeys = append(allKeys, k)
+		}
+		for k := range newLeaves {
+			if _, ok := oldLeaves[k]; !ok {
+				allKeys = append(allKeys, k)
+			}
+		}
+		sort.Strings(allKeys)
+
+		for _, k := range allKeys {
+			oldValue, inOld := oldLeaves[k]
+			newValue, inNew := newLeaves[k]
+			switch {
+			case inOld && inNew && oldValue == newValue:
+				continue
+			case inOld && inNew:
+				changes = append(changes, change{key: k, oldValue: &oldValue, newValue: &newValue})
+			case inOld:
+				changes = append(changes, change{key: k, oldValue: &oldValue})
+			default:
+				changes = append(changes, change{key: k, newValue: &newValue})
+			}
+		}
+	}
+	return
+}
+
+// flattenItem collects the leaves of a configuration subtree into a path -> value map.
+// Empty maps and arrays are leaves too, so that adding or removing them shows up in a diff.
+func flattenItem(key string, item configuration.Item, leaves map[string]string) {
+	if item == nil {
+		return
+	}
+	switch item.Type() {
+	case configuration.IT_Value:
+		leaves[key] = item.Value()
+	case configuration.IT_Map:
+		if len(item.Map()) == 0 {
+			leaves[key] = "{}"
+			return
+		}
+		for k, v := range item.Map() {
+			flattenItem(key + "/" + k, v, leaves)
+		}
+	case configuration.IT_Array:
+		if len(item.Array()) == 0 {
+			leaves[key] = "[]"
+			return
+		}
+		for i, v := range item.Array() {
+			flattenItem(fmt.Sprintf("%s/%d", key, i), v, leaves)
+		}
+	}
+}
+
+func printChanges(o io.Writer, changes []change) {
+	if len(changes) == 0 {
+		fmt.Fprintln(o, "no differences")
+		return
+	}
+	for _, c := range changes {
+		if c.oldValue != nil {
+			fmt.Fprintf(o, "- %s: %s\n", c.key, *c.oldValue)
+		}
+		if c.newValue != nil {
+			fmt.Fprintf(o, "+ %s: %s\n", c.key, *c.newValue)
+		}
+	}
+}
+
+func sortedKeys(subtrees map[string]configuration.Item) (keys []string) {
+	keys = make([]string, 0, len(subtrees))
+	for k := range subtrees {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return
+}
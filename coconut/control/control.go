@@ -28,11 +28,13 @@ package control
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/xlab/treeprint"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -40,6 +42,7 @@ import (
 	"github.com/AliceO2Group/Control/coconut"
 	"github.com/AliceO2Group/Control/coconut/protos"
 	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/common/product"
 	"github.com/briandowns/spinner"
 	"github.com/olekukonko/tablewriter"
 	"github.com/sirupsen/logrus"
@@ -127,6 +130,50 @@ func GetInfo(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, ar
 }
 
 
+func RefreshConfig(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 0 {
+		err = errors.New(fmt.Sprintf("accepts no args, received %d", len(args)))
+		return err
+	}
+
+	var response *pb.RefreshConfigReply
+	response, err = rpc.RefreshConfig(cxt, &pb.RefreshConfigRequest{}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	changes := response.GetChanges()
+	if len(changes) == 0 {
+		fmt.Fprintln(o, "configuration reloaded, no changes")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(o)
+	table.SetHeader([]string{"setting", "old value", "new value", "applied"})
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgBlueColor}
+	table.SetHeaderColor(fg, fg, fg, fg)
+
+	restartRequired := false
+	for _, change := range changes {
+		applied := green("YES")
+		if change.GetRestartRequired() {
+			applied = yellow("on restart")
+			restartRequired = true
+		}
+		table.Append([]string{change.GetKey(), change.GetOldValue(), change.GetNewValue(), applied})
+	}
+	fmt.Fprintf(o, "configuration reloaded, %d change(s):\n\n", len(changes))
+	table.Render()
+	if restartRequired {
+		fmt.Fprintf(o, "\n%s\n", yellow(fmt.Sprintf("some changes only take effect after restarting %s core", product.PRETTY_SHORTNAME)))
+	}
+
+	return nil
+}
+
+
 func Teardown(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	log.Fatal("not implemented yet")
 	os.Exit(1)
@@ -174,8 +221,13 @@ func CreateEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.C
 		return
 	}
 
+	vars, err := parseExtraVars(cmd)
+	if err != nil {
+		return
+	}
+
 	var response *pb.NewEnvironmentReply
-	response, err = rpc.NewEnvironment(cxt, &pb.NewEnvironmentRequest{WorkflowTemplate: wfPath}, grpc.EmptyCallOption{})
+	response, err = rpc.NewEnvironment(cxt, &pb.NewEnvironmentRequest{WorkflowTemplate: wfPath, Vars: vars}, grpc.EmptyCallOption{})
 	if err != nil {
 		return
 	}
@@ -220,6 +272,9 @@ func ShowEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Com
 	_, _ = fmt.Fprintf(o, "created:            %s\n", formatTimestamp(env.GetCreatedWhen()))
 	_, _ = fmt.Fprintf(o, "state:              %s\n", colorState(env.GetState()))
 	_, _ = fmt.Fprintf(o, "run number:         %s\n", rnString)
+	if env.GetConfigurationChanged() {
+		_, _ = fmt.Fprintf(o, "%s\n", yellow("configuration changed since CONFIGURE"))
+	}
 
 	if printTasks {
 		fmt.Fprintln(o, "")
@@ -243,6 +298,45 @@ func ShowEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Com
 }
 
 
+// GraphEnvironment prints the tasks of an environment and the channels between
+// them, either as a Graphviz digraph or as JSON.
+func GraphEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+
+	format, err := cmd.Flags().GetString("format")
+	if err != nil {
+		return
+	}
+	format = strings.ToLower(format)
+	if format != "dot" && format != "json" {
+		err = errors.New(fmt.Sprintf("unsupported graph format %s, must be dot or json", format))
+		return
+	}
+
+	var response *pb.GetEnvironmentTopologyReply
+	response, err = rpc.GetEnvironmentTopology(cxt, &pb.GetEnvironmentTopologyRequest{Id: args[0]}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	switch format {
+	case "json":
+		var output []byte
+		output, err = json.MarshalIndent(response, "", "    ")
+		if err != nil {
+			return
+		}
+		fmt.Fprintln(o, string(output))
+	case "dot":
+		drawTopologyDot(response, o)
+	}
+	return
+}
+
+
 func ControlEnvironment(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	if len(args) != 1 {
 		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
@@ -480,6 +574,11 @@ func QueryRoles(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command,
 			fmt.Fprintf(o, "status:             %s\n", root.GetStatus())
 			fmt.Fprintf(o, "state:              %s\n", root.GetState())
 
+			if len(root.GetVars()) != 0 {
+				fmt.Fprintf(o, "vars:\n")
+				drawTableVarInfos(root.GetVars(), o)
+			}
+
 			fmt.Fprintf(o, "subtree:\n")
 			drawWorkflow(root, o)
 		}
@@ -525,6 +624,66 @@ func ListWorkflowTemplates(cxt context.Context, rpc *coconut.RpcClient, cmd *cob
 	return nil
 }
 
+// RenderWorkflowTemplate loads a workflow template on the core without
+// acquiring any task, and prints the expanded role tree.
+func RenderWorkflowTemplate(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	response, err := renderWorkflowTemplate(cxt, rpc, cmd, args)
+	if err != nil {
+		return err
+	}
+
+	if response.GetWorkflow() != nil {
+		fmt.Fprintf(o, "workflow revision:  %s\n", response.GetWorkflowRevision())
+		drawRenderedWorkflow(response.GetWorkflow(), o)
+		fmt.Fprintln(o)
+	}
+	printWorkflowProblems(o, response.GetProblems())
+
+	return nil
+}
+
+// LintWorkflowTemplate loads a workflow template on the core without
+// acquiring any task, and only reports the problems found in it.
+func LintWorkflowTemplate(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	response, err := renderWorkflowTemplate(cxt, rpc, cmd, args)
+	if err != nil {
+		return err
+	}
+
+	problems := response.GetProblems()
+	if len(problems) == 0 {
+		fmt.Fprintln(o, "No problems found.")
+		return nil
+	}
+	printWorkflowProblems(o, problems)
+
+	return errors.New(fmt.Sprintf("%d problem(s) found in workflow template %s", len(problems), args[0]))
+}
+
+func renderWorkflowTemplate(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string) (response *pb.RenderWorkflowTemplateReply, err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+	vars, err := parseExtraVars(cmd)
+	if err != nil {
+		return
+	}
+
+	response, err = rpc.RenderWorkflowTemplate(cxt, &pb.RenderWorkflowTemplateRequest{WorkflowTemplate: args[0], Vars: vars}, grpc.EmptyCallOption{})
+	return
+}
+
+func printWorkflowProblems(o io.Writer, problems []string) {
+	if len(problems) == 0 {
+		return
+	}
+	fmt.Fprintln(o, yellow(fmt.Sprintf("%d problem(s) found in workflow template:", len(problems))))
+	for _, problem := range problems {
+		fmt.Fprintf(o, "  %s\n", problem)
+	}
+}
+
 // ListRepos lists all available git repositories that are used for configuration.
 func ListRepos(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
 	if len(args) != 0 {
@@ -577,6 +736,7 @@ func AddRepo(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, ar
 
 	if response.GetErrorString() == "" {
 		fmt.Fprintln(o, "Repository succesfully added.")
+		printValidationErrors(o, response.GetValidationErrors())
 	} else {
 		fmt.Fprintln(o, "Cannot add repository:", response.GetErrorString())
 	}
@@ -584,6 +744,16 @@ func AddRepo(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, ar
 	return nil
 }
 
+func printValidationErrors(o io.Writer, validationErrors []string) {
+	if len(validationErrors) == 0 {
+		return
+	}
+	fmt.Fprintln(o, yellow(fmt.Sprintf("%d problem(s) found in task classes and workflow templates:", len(validationErrors))))
+	for _, validationError := range validationErrors {
+		fmt.Fprintf(o, "  %s\n", validationError)
+	}
+}
+
 // RemoveRepo removes a git repository based on the indexes reported by ListRepos.
 // If the default repository is removed, the repository with the lowest index is
 // set as the new default. If all repositories are removed, the backend (consul or file)
@@ -642,6 +812,7 @@ func RefreshRepos(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Comman
 		} else {
 			fmt.Fprintln(o, "Repository refreshed succesfully")
 		}
+		printValidationErrors(o, response.GetValidationErrors())
 	} else {
 		fmt.Fprintln(o, "Repository refresh operation failed:", errorString)
 	}
@@ -674,4 +845,43 @@ func SetDefaultRepo(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Comm
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+func GetRunConfiguration(cxt context.Context, rpc *coconut.RpcClient, cmd *cobra.Command, args []string, o io.Writer) (err error) {
+	if len(args) != 1 {
+		err = errors.New(fmt.Sprintf("accepts 1 arg(s), received %d", len(args)))
+		return
+	}
+	runNumber, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil || runNumber == 0 {
+		err = errors.New(fmt.Sprintf("invalid run number %s", args[0]))
+		return
+	}
+
+	var response *pb.GetRunConfigurationReply
+	response, err = rpc.GetRunConfiguration(cxt, &pb.GetRunConfigurationRequest{RunNumber: uint32(runNumber)}, grpc.EmptyCallOption{})
+	if err != nil {
+		return
+	}
+
+	_, _ = fmt.Fprintf(o, "run number:         %s\n", formatRunNumber(response.GetRunNumber()))
+	_, _ = fmt.Fprintf(o, "environment id:     %s\n", response.GetEnvironmentId())
+	_, _ = fmt.Fprintf(o, "started:            %s\n", formatTimestamp(response.GetStartedWhen()))
+	_, _ = fmt.Fprintf(o, "workflow template:  %s\n", response.GetWorkflowTemplate())
+	_, _ = fmt.Fprintf(o, "workflow revision:  %s\n", response.GetWorkflowRevision())
+
+	for _, t := range response.GetTasks() {
+		_, _ = fmt.Fprintf(o, "\n%s %s on %s\n", blue("task"), t.GetName(), t.GetHostname())
+
+		properties := t.GetProperties()
+		keys := make([]string, 0, len(properties))
+		for k := range properties {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			_, _ = fmt.Fprintf(o, "    %s: %s\n", k, properties[k])
+		}
+	}
+	return
+}
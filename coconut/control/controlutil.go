@@ -25,15 +25,18 @@
 package control
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/AliceO2Group/Control/coconut/protos"
 	"github.com/fatih/color"
 	"github.com/google/uuid"
 	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
 	"github.com/xlab/treeprint"
 )
 
@@ -85,6 +88,11 @@ func colorStateFromNode(node *pb.RoleInfo) string {
 }
 
 func buildTree(tree *treeprint.Tree, node *pb.RoleInfo, level int) {
+	if node.GetPruned() {
+		nodeText := fmt.Sprintf("%-"+strconv.Itoa(50-(4*level))+"s", node.GetName()) + grey(" (pruned: " + node.GetPruneReason() + ")")
+		(*tree).AddMetaNode(grey("DISABLED"), nodeText)
+		return
+	}
 	if len(node.Roles) != 0 {
 		branch := (*tree).AddMetaBranch(colorStateFromNode(node), node.GetName())
 		for _, n := range node.Roles {
@@ -121,6 +129,102 @@ func drawWorkflow(root *pb.RoleInfo, o io.Writer) {
 	fmt.Fprint(o, tree.String())
 }
 
+func buildRenderedTree(tree *treeprint.Tree, node *pb.RenderedRole, level int) {
+	if node.GetPruned() {
+		nodeText := fmt.Sprintf("%-"+strconv.Itoa(50-(4*level))+"s", node.GetName()) + grey(" (pruned: " + node.GetPruneReason() + ")")
+		(*tree).AddMetaNode(grey("DISABLED"), nodeText)
+		return
+	}
+	if len(node.GetTaskClass()) == 0 {
+		branch := (*tree).AddBranch(node.GetName())
+		for _, n := range node.Roles {
+			buildRenderedTree(&branch, n, level + 1)
+		}
+		return
+	}
+
+	// we format to include some padding and then the task class
+	nodeText := fmt.Sprintf("%-"+strconv.Itoa(50-(4*level))+"s", node.GetName()) + yellow(" --> ") + "class " + node.GetTaskClass()
+	if len(node.GetOutboundChannels()) == 0 {
+		(*tree).AddNode(nodeText)
+		return
+	}
+	branch := (*tree).AddBranch(nodeText)
+	for _, ch := range node.GetOutboundChannels() {
+		branch.AddMetaNode(ch.GetType(), ch.GetName() + yellow(" --> ") + ch.GetTarget())
+	}
+}
+
+func drawRenderedWorkflow(root *pb.RenderedRole, o io.Writer) {
+	if root == nil {
+		return
+	}
+	tree := treeprint.New()
+
+	tree.SetValue(root.GetName())
+	for _, n := range root.Roles {
+		buildRenderedTree(&tree, n, 0)
+	}
+	fmt.Fprint(o, tree.String())
+}
+
+// drawTopologyDot prints an environment topology as a Graphviz digraph, with
+// one node per task and one edge per outbound channel. Channels whose target
+// is not a task in the environment point to a node named after the address,
+// or after the target if it could not be resolved.
+func drawTopologyDot(topology *pb.GetEnvironmentTopologyReply, o io.Writer) {
+	fmt.Fprintf(o, "digraph %q {\n", topology.GetEnvironmentId())
+	fmt.Fprintln(o, "    rankdir=LR;")
+	fmt.Fprintln(o, "    node [shape=box];")
+	for _, node := range topology.GetNodes() {
+		label := strings.Join([]string{node.GetRolePath(), node.GetHostname(), node.GetClassName(), node.GetState()}, "\n")
+		fmt.Fprintf(o, "    %q [label=%q];\n", node.GetTaskId(), label)
+	}
+
+	external := make(map[string]bool)
+	for _, edge := range topology.GetEdges() {
+		target := edge.GetTargetTaskId()
+		if len(target) == 0 {
+			if len(edge.GetEndpoint()) != 0 {
+				target = edge.GetEndpoint()
+			} else {
+				target = edge.GetTarget()
+			}
+			if !external[target] {
+				style := "dashed"
+				if len(edge.GetEndpoint()) == 0 {
+					style = "dashed, filled"
+				}
+				fmt.Fprintf(o, "    %q [shape=ellipse, style=%q];\n", target, style)
+				external[target] = true
+			}
+		}
+		label := fmt.Sprintf("%s (%s)", edge.GetName(), edge.GetType())
+		if len(edge.GetEndpoint()) != 0 {
+			label += "\n" + edge.GetEndpoint()
+		}
+		fmt.Fprintf(o, "    %q -> %q [label=%q];\n", edge.GetSourceTaskId(), target, label)
+	}
+	fmt.Fprintln(o, "}")
+}
+
+func parseExtraVars(cmd *cobra.Command) (vars map[string]string, err error) {
+	extraVars, err := cmd.Flags().GetStringSlice("extra-vars")
+	if err != nil {
+		return
+	}
+	vars = make(map[string]string)
+	for _, kv := range extraVars {
+		kvSlice := strings.SplitN(kv, "=", 2)
+		if len(kvSlice) != 2 || len(strings.TrimSpace(kvSlice[0])) == 0 {
+			err = errors.New(fmt.Sprintf("invalid variable %s, expecting key=value", kv))
+			return
+		}
+		vars[strings.TrimSpace(kvSlice[0])] = kvSlice[1]
+	}
+	return
+}
+
 type linePrintFunc func(t *pb.ShortTaskInfo) []string
 
 func drawTableShortTaskInfos(tasks []*pb.ShortTaskInfo, headers []string, linePrint linePrintFunc, o io.Writer) {
@@ -143,6 +247,24 @@ func drawTableShortTaskInfos(tasks []*pb.ShortTaskInfo, headers []string, linePr
 	table.Render()
 }
 
+// drawTableVarInfos prints the effective variables of a role, along with the
+// layer which supplied each of them.
+func drawTableVarInfos(vars []*pb.VarInfo, o io.Writer) {
+	table := tablewriter.NewWriter(o)
+	table.SetHeader([]string{"variable", "value", "source"})
+	table.SetBorder(false)
+	fg := tablewriter.Colors{tablewriter.Bold, tablewriter.FgYellowColor}
+	table.SetHeaderColor(fg, fg, fg)
+
+	data := make([][]string, 0, len(vars))
+	for _, v := range vars {
+		data = append(data, []string{v.GetKey(), v.GetValue(), grey(v.GetSource())})
+	}
+
+	table.AppendBulk(data)
+	table.Render()
+}
+
 func formatTimestamp(rfc3339timestamp string) string {
 	timestamp, err := time.Parse(time.RFC3339, rfc3339timestamp)
 	var formatted string
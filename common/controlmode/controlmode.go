@@ -37,6 +37,8 @@ type ControlMode int
 const(
 	DIRECT ControlMode = iota
 	FAIRMQ
+	BASIC
+	HOOK
 )
 
 func (cm *ControlMode) String() string {
@@ -49,6 +51,10 @@ func (cm *ControlMode) String() string {
 		return "direct"
 	case FAIRMQ:
 		return "fairmq"
+	case BASIC:
+		return "basic"
+	case HOOK:
+		return "hook"
 	}
 	return "direct"
 }
@@ -65,6 +71,10 @@ func (cm *ControlMode) UnmarshalText(b []byte) error {
 		*cm = DIRECT
 	case "fairmq":
 		*cm = FAIRMQ
+	case "basic":
+		*cm = BASIC
+	case "hook":
+		*cm = HOOK
 	default:
 		*cm = DIRECT
 	}
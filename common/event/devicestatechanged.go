@@ -0,0 +1,60 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+import (
+	"github.com/AliceO2Group/Control/executor/protos"
+)
+
+// DeviceStateChanged is sent by the executor to the core when the state of a task
+// changed without the executor having asked for it, for instance if the device
+// went to ERROR on its own. State is the transitioner-independent state, i.e.
+// STANDBY, CONFIGURED, RUNNING, ERROR or DONE.
+type DeviceStateChanged struct {
+	Origin      DeviceEventOrigin     `json:"origin"`
+	State       string                `json:"state"`
+	Trigger     pb.StateChangeTrigger `json:"trigger"`
+	MessageType string                `json:"_messageType"`
+}
+
+func NewDeviceStateChanged(origin DeviceEventOrigin, state string, trigger pb.StateChangeTrigger) *DeviceStateChanged {
+	return &DeviceStateChanged{
+		Origin:      origin,
+		State:       state,
+		Trigger:     trigger,
+		MessageType: "DeviceStateChanged",
+	}
+}
+
+func (e *DeviceStateChanged) GetName() string {
+	return "DEVICE_STATE_CHANGED"
+}
+
+func (e *DeviceStateChanged) GetOrigin() DeviceEventOrigin {
+	if e == nil {
+		return DeviceEventOrigin{}
+	}
+	return e.Origin
+}
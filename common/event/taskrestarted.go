@@ -0,0 +1,56 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package event
+
+// TaskRestarted is sent by the executor to the core after a task process
+// exited unexpectedly and was relaunched according to its restart policy.
+// By the time this message is sent, the new process has reached State
+// (normally STANDBY) and is ready to receive the last configuration again.
+type TaskRestarted struct {
+	Origin       DeviceEventOrigin `json:"origin"`
+	RestartCount uint              `json:"restartCount"`
+	State        string            `json:"state"`
+	MessageType  string            `json:"_messageType"`
+}
+
+func NewTaskRestarted(origin DeviceEventOrigin, restartCount uint, state string) *TaskRestarted {
+	return &TaskRestarted{
+		Origin:       origin,
+		RestartCount: restartCount,
+		State:        state,
+		MessageType:  "TaskRestarted",
+	}
+}
+
+func (e *TaskRestarted) GetName() string {
+	return "TASK_RESTARTED"
+}
+
+func (e *TaskRestarted) GetOrigin() DeviceEventOrigin {
+	if e == nil {
+		return DeviceEventOrigin{}
+	}
+	return e.Origin
+}
@@ -0,0 +1,99 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package common
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const defaultHookTimeout = 30 * time.Second
+
+var hookEvents = []string{"CONFIGURE", "START", "STOP", "RESET", "EXIT", "RECOVER", "GO_ERROR"}
+
+// HookInfo describes the commands which the executor runs on each transition of a task
+// in HOOK control mode. Commands is keyed by transition event, and each command is run
+// with /bin/sh -c. A transition without a command always succeeds.
+type HookInfo struct {
+	Commands map[string]string `json:"commands,omitempty" yaml:"commands,omitempty"`
+	Timeout  time.Duration     `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+}
+
+// IsHookEvent returns whether a hook command can be set for the transition event evt.
+func IsHookEvent(evt string) bool {
+	evt = strings.ToUpper(strings.TrimSpace(evt))
+	for _, hookEvent := range hookEvents {
+		if evt == hookEvent {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *HookInfo) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _hookInfo struct {
+		Commands map[string]string `yaml:"commands"`
+		Timeout  *string           `yaml:"timeout"`
+	}
+	aux := _hookInfo{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+
+	m.Commands = make(map[string]string, len(aux.Commands))
+	for evt, command := range aux.Commands {
+		evt = strings.ToUpper(strings.TrimSpace(evt))
+		if !IsHookEvent(evt) {
+			err = fmt.Errorf("invalid hook for unknown transition event %s", evt)
+			return
+		}
+		m.Commands[evt] = command
+	}
+
+	m.Timeout = defaultHookTimeout
+	if aux.Timeout != nil {
+		m.Timeout, err = time.ParseDuration(*aux.Timeout)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (m *HookInfo) Copy() *HookInfo {
+	if m == nil {
+		return nil
+	}
+	hooks := HookInfo{
+		Commands: make(map[string]string, len(m.Commands)),
+		Timeout:  m.Timeout,
+	}
+	for k, v := range m.Commands {
+		hooks.Commands[k] = v
+	}
+	return &hooks
+}
@@ -0,0 +1,114 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/AliceO2Group/Control/common/restartpolicy"
+)
+
+const (
+	defaultRestartMaxRetries = 3
+	defaultRestartBackoff    = 1 * time.Second
+	maxRestartBackoff        = 5 * time.Minute
+)
+
+// RestartInfo describes what the executor should do if a task process
+// exits with an error without having been asked to.
+// If the policy is ON_FAILURE, the process is relaunched up to MaxRetries
+// times, waiting Backoff before the first relaunch and doubling the delay
+// on every subsequent attempt, up to a maximum of 5 minutes.
+type RestartInfo struct {
+	Policy     restartpolicy.RestartPolicy `json:"policy" yaml:"policy"`
+	MaxRetries uint                        `json:"maxRetries" yaml:"maxRetries"`
+	Backoff    time.Duration               `json:"backoff" yaml:"backoff"`
+}
+
+func (m *RestartInfo) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	type _restartInfo struct {
+		Policy     restartpolicy.RestartPolicy `yaml:"policy"`
+		MaxRetries *string                     `yaml:"maxRetries"`
+		Backoff    *string                     `yaml:"backoff"`
+	}
+	aux := _restartInfo{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+
+	m.Policy = aux.Policy
+	m.MaxRetries = defaultRestartMaxRetries
+	if aux.MaxRetries != nil {
+		var maxRetries uint64
+		maxRetries, err = strconv.ParseUint(*aux.MaxRetries, 10, 32)
+		if err != nil {
+			return
+		}
+		m.MaxRetries = uint(maxRetries)
+	}
+	m.Backoff = defaultRestartBackoff
+	if aux.Backoff != nil {
+		m.Backoff, err = time.ParseDuration(*aux.Backoff)
+		if err != nil {
+			return
+		}
+		if m.Backoff < 0 {
+			err = errors.New(fmt.Sprintf("invalid restart backoff %s, expecting a non-negative duration", *aux.Backoff))
+			return
+		}
+	}
+	return
+}
+
+// ShouldRestart returns whether a process which already went through
+// restartCount restarts should be relaunched after an unexpected exit.
+func (m *RestartInfo) ShouldRestart(restartCount uint) bool {
+	if m == nil {
+		return false
+	}
+	return m.Policy == restartpolicy.ON_FAILURE && restartCount < m.MaxRetries
+}
+
+// GetBackoff returns how long to wait before performing the relaunch
+// number restartCount+1, clamped to maxRestartBackoff.
+func (m *RestartInfo) GetBackoff(restartCount uint) (backoff time.Duration) {
+	if m == nil || m.Backoff <= 0 {
+		return 0
+	}
+	backoff = m.Backoff
+	// Doubling one step at a time, rather than shifting by restartCount,
+	// so that a large restartCount can't overflow into a negative delay
+	for i := uint(0); i < restartCount && backoff < maxRestartBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRestartBackoff {
+		backoff = maxRestartBackoff
+	}
+	return
+}
@@ -0,0 +1,41 @@
+package common_test
+
+import (
+	"time"
+
+	. "github.com/AliceO2Group/Control/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("restart info", func() {
+	It("should double the backoff on every attempt", func() {
+		var ri RestartInfo
+		Expect(yaml.Unmarshal([]byte("policy: on-failure\nbackoff: 2s"), &ri)).To(Succeed())
+		Expect(ri.GetBackoff(0)).To(Equal(2 * time.Second))
+		Expect(ri.GetBackoff(1)).To(Equal(4 * time.Second))
+		Expect(ri.GetBackoff(3)).To(Equal(16 * time.Second))
+	})
+
+	It("should clamp the backoff for a large number of retries", func() {
+		var ri RestartInfo
+		Expect(yaml.Unmarshal([]byte("policy: on-failure\nmaxRetries: 4294967295"), &ri)).To(Succeed())
+		Expect(ri.ShouldRestart(1000)).To(BeTrue())
+
+		previous := time.Duration(0)
+		for _, restartCount := range []uint{0, 8, 33, 34, 63, 64, 1000, 4294967294} {
+			backoff := ri.GetBackoff(restartCount)
+			Expect(backoff).To(BeNumerically(">", 0), "restart %d", restartCount)
+			Expect(backoff).To(BeNumerically(">=", previous), "restart %d", restartCount)
+			Expect(backoff).To(BeNumerically("<=", 5*time.Minute), "restart %d", restartCount)
+			previous = backoff
+		}
+		Expect(ri.GetBackoff(1000)).To(Equal(5 * time.Minute))
+	})
+
+	It("should reject a negative backoff", func() {
+		var ri RestartInfo
+		Expect(yaml.Unmarshal([]byte("policy: on-failure\nbackoff: -1s"), &ri)).NotTo(Succeed())
+	})
+})
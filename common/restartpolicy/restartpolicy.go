@@ -0,0 +1,88 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package restartpolicy contains some enums for choosing what the
+// executor should do when a task process exits unexpectedly.
+package restartpolicy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type RestartPolicy int
+
+const(
+	NEVER RestartPolicy = iota
+	ON_FAILURE
+)
+
+func (rp *RestartPolicy) String() string {
+	if rp == nil {
+		return ""
+	}
+
+	switch *rp {
+	case NEVER:
+		return "never"
+	case ON_FAILURE:
+		return "on-failure"
+	}
+	return "never"
+}
+
+func (rp *RestartPolicy) UnmarshalJSON(b []byte) error {
+	return rp.UnmarshalText(b)
+}
+
+// UnmarshalText accepts never and on-failure, case insensitive. An empty
+// value means no policy was set, which is the same as never.
+func (rp *RestartPolicy) UnmarshalText(b []byte) error {
+	str := strings.ToLower(strings.TrimSpace(strings.Trim(string(b), `"`)))
+
+	switch str {
+	case "never", "":
+		*rp = NEVER
+	case "on-failure", "onfailure", "on_failure":
+		*rp = ON_FAILURE
+	default:
+		return errors.New(fmt.Sprintf("unknown restart policy %s, expecting never or on-failure", str))
+	}
+
+	return nil
+}
+
+func (rp *RestartPolicy) MarshalJSON() (text []byte, err error) {
+	text, err = rp.MarshalText()
+	return []byte(fmt.Sprintf("\"%s\"", text)), err
+}
+
+func (rp *RestartPolicy) MarshalText() (text []byte, err error) {
+	if rp == nil {
+		return []byte{}, errors.New("cannot marshal nil RestartPolicy")
+	}
+
+	return []byte(rp.String()), nil
+}
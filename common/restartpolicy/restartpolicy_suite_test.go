@@ -0,0 +1,13 @@
+package restartpolicy_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestRestartpolicy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Restartpolicy Suite")
+}
@@ -0,0 +1,48 @@
+package restartpolicy_test
+
+import (
+	"encoding/json"
+
+	. "github.com/AliceO2Group/Control/common/restartpolicy"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("restart policy", func() {
+	It("should parse the known policies", func() {
+		var rp RestartPolicy
+		for text, expected := range map[string]RestartPolicy{
+			"never":      NEVER,
+			"on-failure": ON_FAILURE,
+			"OnFailure":  ON_FAILURE,
+			"on_failure": ON_FAILURE,
+			"":           NEVER,
+		} {
+			Expect(rp.UnmarshalText([]byte(text))).To(Succeed())
+			Expect(rp).To(Equal(expected), "parsing %q", text)
+		}
+	})
+
+	It("should reject unknown policies", func() {
+		rp := ON_FAILURE
+		Expect(rp.UnmarshalText([]byte("always"))).To(MatchError(ContainSubstring("unknown restart policy always")))
+		Expect(rp).To(Equal(ON_FAILURE))
+
+		var fromYaml struct {
+			Policy RestartPolicy `yaml:"policy"`
+		}
+		Expect(yaml.Unmarshal([]byte("policy: on-fail"), &fromYaml)).NotTo(Succeed())
+	})
+
+	It("should survive a JSON round trip", func() {
+		rp := ON_FAILURE
+		data, err := json.Marshal(&rp)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal(`"on-failure"`))
+
+		var parsed RestartPolicy
+		Expect(json.Unmarshal(data, &parsed)).To(Succeed())
+		Expect(parsed).To(Equal(ON_FAILURE))
+	})
+})
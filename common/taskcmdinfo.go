@@ -30,4 +30,7 @@ type TaskCommandInfo struct {
 	CommandInfo
 	ControlPort uint64                  `json:"controlPort"`
 	ControlMode controlmode.ControlMode `json:"controlMode"`
+	Restart     RestartInfo             `json:"restart"`
+	Hooks       *HookInfo               `json:"hooks,omitempty"`
+	ShmSession  string                  `json:"shmSession,omitempty"`
 }
@@ -29,6 +29,8 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+
+	"gopkg.in/yaml.v2"
 )
 
 type ItemType int
@@ -158,3 +160,15 @@ func intfToItem(intf interface{}) (item Item, err error) {
 	}
 	return
 }
+
+// ItemFromYaml parses a YAML document (or a JSON one, since JSON is a subset of YAML)
+// into a configuration Item.
+func ItemFromYaml(data []byte) (item Item, err error) {
+	var raw interface{}
+	err = yaml.Unmarshal(data, &raw)
+	if err != nil {
+		return
+	}
+	item, err = intfToItem(raw)
+	return
+}
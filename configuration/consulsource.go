@@ -25,13 +25,24 @@
 package configuration
 
 import (
+	"context"
 	"github.com/hashicorp/consul/api"
 	"errors"
+	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 	"gopkg.in/yaml.v2"
 )
 
+// Consul refuses transactions with more than 64 operations
+const maxTxnOps = 64
+
+// How long a blocking query may wait for changes before Consul returns anyway
+const consulWatchWait = 5 * time.Minute
+
 type ConsulSource struct {
 	uri string
 	kv  *api.KV
@@ -56,12 +67,21 @@ func (cc *ConsulSource) GetNextUInt32(key string) (value uint32, err error) {
 	if err != nil {
 		return
 	}
-	var value64 uint64
-	value64, err = strconv.ParseUint(string(kvp.Value[:]), 10, 32)
-	if err != nil {
+	if kvp == nil {
+		// ModifyIndex 0 makes the CAS below succeed only if the key still doesn't exist
+		kvp = &api.KVPair{Key: formatKey(key)}
+	} else {
+		var value64 uint64
+		value64, err = strconv.ParseUint(strings.TrimSpace(string(kvp.Value[:])), 10, 32)
+		if err != nil {
+			return
+		}
+		value = uint32(value64)
+	}
+	if value == math.MaxUint32 {
+		err = errors.New(fmt.Sprintf("counter %s overflow", key))
 		return
 	}
-	value = uint32(value64)
 	value++
 	kvp.Value = []byte(strconv.FormatUint(uint64(value), 10))
 	var ok bool
@@ -80,6 +100,10 @@ func (cc *ConsulSource) Get(key string) (value string, err error) {
 	if err != nil {
 		return
 	}
+	if kvp == nil {
+		err = errors.New(fmt.Sprintf("no value for key %s", key))
+		return
+	}
 	value = string(kvp.Value[:])
 	return
 }
@@ -99,19 +123,30 @@ func (cc *ConsulSource) GetRecursive(key string) (value Item, err error) {
 	if err != nil {
 		return
 	}
+	kvps = filterSubtree(requestKey, kvps)
+
+	// A single value was requested, there's no subtree to build
+	if len(kvps) == 1 && kvps[0].Key == requestKey && len(requestKey) != 0 {
+		return String(kvps[0].Value), nil
+	}
+
 	for _, kvp := range kvps {
 		kvp.Key = stripRequestKey(requestKey, kvp.Key)
 	}
 	return mapify(kvps), nil
 }
 
+// GetRecursiveYaml exports a subtree as YAML. Consul has no notion of arrays,
+// so maps whose keys are exactly 0..n-1, which is how PutRecursive stores an
+// Array, are exported as YAML lists. Unlike GetRecursive, which always returns
+// such maps as they are stored.
 func (cc *ConsulSource) GetRecursiveYaml(key string) (value []byte, err error) {
 	var item Item
 	item, err = cc.GetRecursive(key)
 	if err != nil {
 		return
 	}
-	value, err = yaml.Marshal(item)
+	value, err = yaml.Marshal(arrayify(item))
 	return
 }
 
@@ -121,14 +156,105 @@ func (cc *ConsulSource) Put(key string, value string) (err error) {
 	return
 }
 
-func (cc *ConsulSource) PutRecursive(string, Item) error {
-	// FIXME
-	panic("implement me")
+// PutRecursive writes a whole configuration subtree at key, replacing whatever was there.
+// Map and Array items are flattened into one KV pair per leaf, with map keys and array indices
+// as path components (e.g. key/roles/0/name), which is the layout GetRecursive reads back.
+// GetRecursive returns an Array as a Map keyed by its indices, only GetRecursiveYaml turns
+// it back into a list.
+// Empty maps and arrays become folder keys (e.g. key/env/), and are read back as empty maps.
+// The write is performed with Consul transactions, which are limited to maxTxnOps operations
+// (including the deletion of the previous subtree). Only a write which fits in a single
+// transaction is atomic. A larger one is split into several transactions, so readers may see
+// it partially applied, and if one of them fails the previous contents of key are written
+// back on a best-effort basis, which can itself fail.
+func (cc *ConsulSource) PutRecursive(key string, value Item) (err error) {
+	if value == nil {
+		return errors.New("cannot put nil configuration item")
+	}
+	requestKey := strings.TrimRight(formatKey(key), "/")
+	if len(requestKey) == 0 {
+		return errors.New("cannot put configuration subtree at the root")
+	}
+
+	ops := make(api.KVTxnOps, 0)
+	ops = append(ops, deleteSubtreeOps(requestKey)...)
+	ops = append(ops, flattenItem(requestKey, value)...)
+
+	if len(ops) <= maxTxnOps {
+		return cc.doTxn(ops)
+	}
+
+	// Too many operations for a single transaction: we keep the current contents of the
+	// subtree around, so we can roll back by hand if any chunk fails.
+	var previous api.KVPairs
+	previous, _, err = cc.kv.List(requestKey, nil)
+	if err != nil {
+		return
+	}
+	previous = filterSubtree(requestKey, previous)
+
+	err = cc.doChunkedTxn(ops)
+	if err != nil {
+		rollbackOps := deleteSubtreeOps(requestKey)
+		for _, kvp := range previous {
+			rollbackOps = append(rollbackOps, &api.KVTxnOp{Verb: api.KVSet, Key: kvp.Key, Value: kvp.Value, Flags: kvp.Flags})
+		}
+		rollbackErr := cc.doChunkedTxn(rollbackOps)
+		if rollbackErr != nil {
+			err = fmt.Errorf("%s, and rollback failed: %s", err.Error(), rollbackErr.Error())
+		}
+	}
+	return
+}
+
+func (cc *ConsulSource) PutRecursiveYaml(key string, value []byte) (err error) {
+	var (
+		raw interface{}
+		cooked Item
+	)
+	err = yaml.Unmarshal(value, &raw)
+	if err != nil {
+		return
+	}
+
+	cooked, err = intfToItem(raw)
+	if err != nil {
+		return
+	}
+
+	err = cc.PutRecursive(key, cooked)
+	return
 }
 
-func (cc *ConsulSource) PutRecursiveYaml(string, []byte) error {
-	// FIXME
-	panic("implement me")
+func (cc *ConsulSource) doTxn(ops api.KVTxnOps) (err error) {
+	ok, response, _, err := cc.kv.Txn(ops, nil)
+	if err != nil {
+		return
+	}
+	if !ok {
+		errMsgs := make([]string, 0)
+		if response != nil {
+			for _, txnErr := range response.Errors {
+				errMsgs = append(errMsgs, fmt.Sprintf("operation %d: %s", txnErr.OpIndex, txnErr.What))
+			}
+		}
+		err = fmt.Errorf("consul transaction rolled back: %s", strings.Join(errMsgs, "; "))
+	}
+	return
+}
+
+func (cc *ConsulSource) doChunkedTxn(ops api.KVTxnOps) (err error) {
+	for begin := 0; begin < len(ops); begin += maxTxnOps {
+		end := begin + maxTxnOps
+		if end > len(ops) {
+			end = len(ops)
+		}
+		err = cc.doTxn(ops[begin:end])
+		if err != nil {
+			return
+		}
+	}
+	return
 }
 
 func (cc *ConsulSource) Exists(key string) (exists bool, err error) {
@@ -140,12 +266,178 @@ func (cc *ConsulSource) Exists(key string) (exists bool, err error) {
 	return
 }
 
+// Watch implements WatchableSource with Consul blocking queries on the prefix.
+func (cc *ConsulSource) Watch(prefix string) (<-chan Change, CancelFunc) {
+	changes := make(chan Change)
+	ctx, cancel := context.WithCancel(context.Background())
+	requestKey := strings.TrimRight(formatKey(prefix), "/")
+
+	go func() {
+		defer close(changes)
+
+		var (
+			lastIndex uint64
+			previous map[string]string
+			retry = watchRetryMin
+		)
+		for {
+			opts := (&api.QueryOptions{WaitIndex: lastIndex, WaitTime: consulWatchWait}).WithContext(ctx)
+			kvps, meta, err := cc.kv.List(requestKey, opts)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				select {
+				case <-time.After(retry):
+					retry = nextWatchRetry(retry)
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+			retry = watchRetryMin
+
+			// The index can go backwards e.g. after a Consul snapshot restore, in which
+			// case we start over, and it should never be 0, otherwise we'd be
+			// busy-looping on non-blocking queries
+			index := meta.LastIndex
+			if index < lastIndex {
+				index = 0
+			}
+			unchanged := previous != nil && index == lastIndex
+			lastIndex = index
+			if lastIndex < 1 {
+				lastIndex = 1
+			}
+			if unchanged {
+				continue
+			}
+
+			current := make(map[string]string)
+			for _, kvp := range filterSubtree(requestKey, kvps) {
+				if strings.HasSuffix(kvp.Key, "/") && len(kvp.Value) == 0 { // folder
+					continue
+				}
+				current[kvp.Key] = string(kvp.Value)
+			}
+			if previous != nil {
+				for _, change := range diffLeaves(previous, current) {
+					select {
+					case changes <- change:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+			previous = current
+		}
+	}()
+
+	return changes, CancelFunc(cancel)
+}
+
 func formatKey(key string) (consulKey string) {
 	// Trim leading slashes
 	consulKey = strings.TrimLeft(key, "/")
 	return
 }
 
+// filterSubtree drops the KV pairs which share a prefix with requestKey without belonging to
+// its subtree (e.g. foo/barbaz when asking for foo/bar).
+func filterSubtree(requestKey string, kvps api.KVPairs) (filtered api.KVPairs) {
+	if len(requestKey) == 0 || strings.HasSuffix(requestKey, "/") {
+		return kvps
+	}
+	filtered = make(api.KVPairs, 0, len(kvps))
+	for _, kvp := range kvps {
+		if kvp.Key == requestKey || strings.HasPrefix(kvp.Key, requestKey + "/") {
+			filtered = append(filtered, kvp)
+		}
+	}
+	return
+}
+
+func deleteSubtreeOps(key string) api.KVTxnOps {
+	return api.KVTxnOps{
+		&api.KVTxnOp{Verb: api.KVDelete, Key: key},
+		&api.KVTxnOp{Verb: api.KVDeleteTree, Key: key + "/"},
+	}
+}
+
+// flattenItem turns a configuration subtree into a list of KV set operations, one per leaf.
+func flattenItem(key string, item Item) (ops api.KVTxnOps) {
+	ops = make(api.KVTxnOps, 0)
+	switch item.Type() {
+	case IT_Value:
+		ops = append(ops, &api.KVTxnOp{Verb: api.KVSet, Key: key, Value: []byte(item.Value())})
+	case IT_Map:
+		m := item.Map()
+		if len(m) == 0 {
+			ops = append(ops, &api.KVTxnOp{Verb: api.KVSet, Key: key + "/"})
+			return
+		}
+		// We sort the keys so that the operations always come out in the same order
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			ops = append(ops, flattenItem(key + "/" + k, m[k])...)
+		}
+	case IT_Array:
+		a := item.Array()
+		if len(a) == 0 {
+			ops = append(ops, &api.KVTxnOp{Verb: api.KVSet, Key: key + "/"})
+			return
+		}
+		for i, v := range a {
+			ops = append(ops, flattenItem(key + "/" + strconv.Itoa(i), v)...)
+		}
+	}
+	return
+}
+
+// arrayify returns item with every Map whose keys are exactly the indices 0..len-1, as
+// written by PutRecursive for an Array item, turned into an Array, at any depth.
+func arrayify(item Item) Item {
+	if item == nil {
+		return nil
+	}
+	switch item.Type() {
+	case IT_Map:
+		m := make(Map, len(item.Map()))
+		for k, v := range item.Map() {
+			m[k] = arrayify(v)
+		}
+		return arrayifyMap(m)
+	case IT_Array:
+		a := make(Array, len(item.Array()))
+		for i, v := range item.Array() {
+			a[i] = arrayify(v)
+		}
+		return a
+	}
+	return item
+}
+
+// arrayifyMap returns an Array if the keys of m are exactly the indices 0..len(m)-1,
+// otherwise it returns m unchanged.
+func arrayifyMap(m Map) Item {
+	if len(m) == 0 {
+		return m
+	}
+	a := make(Array, len(m))
+	for i := 0; i < len(m); i++ {
+		v, ok := m[strconv.Itoa(i)]
+		if !ok {
+			return m
+		}
+		a[i] = v
+	}
+	return a
+}
+
 func stripRequestKey(requestKey string, responseKey string) string {
 	// The request key is prefixed to the response keys, this strips that from it.
 	return strings.TrimPrefix(responseKey, requestKey)
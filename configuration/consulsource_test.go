@@ -0,0 +1,408 @@
+package configuration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/AliceO2Group/Control/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+// consulStandIn is a minimal in-memory imitation of the Consul KV and transaction
+// HTTP endpoints, just enough for exercising ConsulSource.
+type consulStandIn struct {
+	mu        sync.Mutex
+	kv        map[string][]byte
+	index     uint64
+	failOnKey string
+	txnCount  int
+}
+
+type standInKVPair struct {
+	Key         string
+	Value       []byte
+	CreateIndex uint64
+	ModifyIndex uint64
+}
+
+type standInTxnOp struct {
+	KV *struct {
+		Verb  string
+		Key   string
+		Value []byte
+	}
+}
+
+func newConsulStandIn() *consulStandIn {
+	return &consulStandIn{kv: make(map[string][]byte)}
+}
+
+func (s *consulStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/v1/txn" && r.Method == http.MethodPut:
+		s.serveTxn(w, r)
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/"):
+		s.serveKV(w, r, strings.TrimPrefix(r.URL.Path, "/v1/kv/"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *consulStandIn) serveKV(w http.ResponseWriter, r *http.Request, key string) {
+	query := r.URL.Query()
+	_, recurse := query["recurse"]
+	_, keysOnly := query["keys"]
+
+	switch r.Method {
+	case http.MethodGet:
+		// Blocking query: we wait until something changes, or the wait time is up
+		if waitIndex, err := strconv.ParseUint(query.Get("index"), 10, 64); err == nil && waitIndex > 0 {
+			deadline := time.Now().Add(2 * time.Second)
+			for s.index <= waitIndex && time.Now().Before(deadline) {
+				s.mu.Unlock()
+				time.Sleep(10 * time.Millisecond)
+				s.mu.Lock()
+			}
+		}
+		matching := s.sortedKeys(func(k string) bool {
+			if recurse || keysOnly {
+				return strings.HasPrefix(k, key)
+			}
+			return k == key
+		})
+		if len(matching) == 0 {
+			w.Header().Set("X-Consul-Index", fmt.Sprintf("%d", s.index))
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("X-Consul-Index", fmt.Sprintf("%d", s.index))
+		if keysOnly {
+			json.NewEncoder(w).Encode(matching)
+			return
+		}
+		kvps := make([]standInKVPair, 0, len(matching))
+		for _, k := range matching {
+			kvps = append(kvps, standInKVPair{Key: k, Value: s.kv[k], CreateIndex: s.index, ModifyIndex: s.index})
+		}
+		json.NewEncoder(w).Encode(kvps)
+	case http.MethodPut:
+		body, _ := ioutil.ReadAll(r.Body)
+		s.index++
+		s.kv[key] = body
+		w.Write([]byte("true"))
+	case http.MethodDelete:
+		for _, k := range s.sortedKeys(func(k string) bool {
+			return k == key || (recurse && strings.HasPrefix(k, key))
+		}) {
+			delete(s.kv, k)
+		}
+		s.index++
+		w.Write([]byte("true"))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *consulStandIn) serveTxn(w http.ResponseWriter, r *http.Request) {
+	var ops []standInTxnOp
+	err := json.NewDecoder(r.Body).Decode(&ops)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if len(ops) > 64 {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		w.Write([]byte("too many operations"))
+		return
+	}
+	s.txnCount++
+
+	// All operations are applied to a copy, which replaces the store only if
+	// the whole transaction succeeds.
+	staged := make(map[string][]byte, len(s.kv))
+	for k, v := range s.kv {
+		staged[k] = v
+	}
+	results := make([]map[string]interface{}, 0)
+	for i, op := range ops {
+		if op.KV == nil || (len(s.failOnKey) != 0 && op.KV.Key == s.failOnKey) {
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"Errors": []map[string]interface{}{{"OpIndex": i, "What": "injected failure"}},
+			})
+			return
+		}
+		switch op.KV.Verb {
+		case "set":
+			staged[op.KV.Key] = op.KV.Value
+			results = append(results, map[string]interface{}{"KV": standInKVPair{Key: op.KV.Key}})
+		case "delete":
+			delete(staged, op.KV.Key)
+		case "delete-tree":
+			for k := range staged {
+				if strings.HasPrefix(k, op.KV.Key) {
+					delete(staged, k)
+				}
+			}
+		default:
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"Errors": []map[string]interface{}{{"OpIndex": i, "What": "unsupported verb " + op.KV.Verb}},
+			})
+			return
+		}
+	}
+	s.kv = staged
+	s.index++
+	json.NewEncoder(w).Encode(map[string]interface{}{"Results": results})
+}
+
+func (s *consulStandIn) sortedKeys(filter func(string) bool) (keys []string) {
+	keys = make([]string, 0)
+	for k := range s.kv {
+		if filter(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return
+}
+
+func (s *consulStandIn) set(key string, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.index++
+	s.kv[key] = []byte(value)
+}
+
+func (s *consulStandIn) snapshot() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	snap := make(map[string]string, len(s.kv))
+	for k, v := range s.kv {
+		snap[k] = string(v)
+	}
+	return snap
+}
+
+var _ = Describe("ConsulSource", func() {
+	var (
+		c       Source
+		err     error
+		standIn *consulStandIn
+		server  *httptest.Server
+
+		recursivePutMap = Map{
+			"firstKey": String("one"),
+			"secondKey": Array{
+				Map{
+					"name": String("first"),
+					"type": String("an array item"),
+				},
+				Map{
+					"name": String("second"),
+					"type": String("an array item"),
+				},
+			},
+			"thirdKey": Map{
+				"just some": String("stuff"),
+			},
+		}
+		recursivePutArray = Array{
+			Map{
+				"name": String("first"),
+				"properties": Map{
+					"just some": String("stuff"),
+				},
+			},
+			String("second"),
+		}
+		recursivePutString = String("this is a bit underwhelming compared to the other two...")
+
+		// Consul has no arrays, GetRecursive returns them as maps keyed by index
+		recursivePutArrayStored = Map{
+			"0": Map{
+				"name": String("first"),
+				"properties": Map{
+					"just some": String("stuff"),
+				},
+			},
+			"1": String("second"),
+		}
+		recursivePutMapStored = Map{
+			"firstKey": String("one"),
+			"secondKey": Map{
+				"0": Map{
+					"name": String("first"),
+					"type": String("an array item"),
+				},
+				"1": Map{
+					"name": String("second"),
+					"type": String("an array item"),
+				},
+			},
+			"thirdKey": Map{
+				"just some": String("stuff"),
+			},
+		}
+	)
+
+	BeforeEach(func() {
+		standIn = newConsulStandIn()
+		standIn.kv["o2/control/globals/config_basedir"] = []byte("/etc/o2")
+		standIn.kv["o2/control/tasks/0/name"] = []byte("sampler")
+		standIn.kv["o2/control/tasks/0/wants/cpu"] = []byte("1")
+		standIn.kv["o2/control/tasks/0/wants/memory"] = []byte("256")
+		standIn.kv["o2/control/tasksExtra/name"] = []byte("unrelated")
+		server = httptest.NewServer(standIn)
+
+		c, err = NewSource("consul://" + strings.TrimPrefix(server.URL, "http://"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("to add a new subtree or value", func() {
+		It("should correctly push a configuration.Map", func() {
+			putErr := c.PutRecursive("o2/control/newMap", recursivePutMap)
+			Expect(putErr).NotTo(HaveOccurred())
+			Expect(c.GetRecursive("o2/control/newMap")).To(Equal(recursivePutMapStored))
+		})
+
+		It("should flatten the subtree into one key per leaf", func() {
+			putErr := c.PutRecursive("o2/control/newMap", recursivePutMap)
+			Expect(putErr).NotTo(HaveOccurred())
+			Expect(standIn.snapshot()).To(HaveKeyWithValue("o2/control/newMap/secondKey/1/name", "second"))
+			Expect(standIn.snapshot()).To(HaveKeyWithValue("o2/control/newMap/thirdKey/just some", "stuff"))
+			Expect(c.Get("o2/control/newMap/firstKey")).To(Equal("one"))
+		})
+
+		It("should correctly push a configuration.Array", func() {
+			putErr := c.PutRecursive("o2/control/newArray", recursivePutArray)
+			Expect(putErr).NotTo(HaveOccurred())
+			Expect(c.GetRecursive("o2/control/newArray")).To(Equal(recursivePutArrayStored))
+		})
+
+		It("should export arrays as YAML lists", func() {
+			putErr := c.PutRecursive("o2/control/newMap", recursivePutMap)
+			Expect(putErr).NotTo(HaveOccurred())
+			marshalled, marshErr := yaml.Marshal(recursivePutMap)
+			Expect(marshErr).NotTo(HaveOccurred())
+			Expect(c.GetRecursiveYaml("o2/control/newMap")).To(Equal(marshalled))
+		})
+
+		It("should correctly push a configuration.String", func() {
+			putErr := c.PutRecursive("o2/control/newString", recursivePutString)
+			Expect(putErr).NotTo(HaveOccurred())
+			Expect(c.GetRecursive("o2/control/newString")).To(BeEquivalentTo(recursivePutString))
+		})
+
+		It("should correctly push a YAML map", func() {
+			marshalled, marshErr := yaml.Marshal(recursivePutMap)
+			Expect(marshErr).NotTo(HaveOccurred())
+			putErr := c.PutRecursiveYaml("o2/control/newYamlMap", marshalled)
+			Expect(putErr).NotTo(HaveOccurred())
+			Expect(c.GetRecursiveYaml("o2/control/newYamlMap")).To(Equal(marshalled))
+		})
+
+		It("should correctly push a YAML array", func() {
+			marshalled, marshErr := yaml.Marshal(recursivePutArray)
+			Expect(marshErr).NotTo(HaveOccurred())
+			putErr := c.PutRecursiveYaml("o2/control/newYamlArray", marshalled)
+			Expect(putErr).NotTo(HaveOccurred())
+			Expect(c.GetRecursiveYaml("o2/control/newYamlArray")).To(Equal(marshalled))
+		})
+	})
+
+	Context("to replace/update an existing subtree or value", func() {
+		It("should replace the whole subtree and leave its siblings alone", func() {
+			putErr := c.PutRecursive("o2/control/tasks", recursivePutArray)
+			Expect(putErr).NotTo(HaveOccurred())
+			Expect(c.GetRecursive("o2/control/tasks")).To(Equal(recursivePutArrayStored))
+			Expect(c.Exists("o2/control/tasks/0/wants/cpu")).To(BeFalse())
+			Expect(c.Get("o2/control/tasksExtra/name")).To(Equal("unrelated"))
+		})
+
+		It("should replace a subtree with a single value", func() {
+			putErr := c.PutRecursive("o2/control/tasks/0/wants", recursivePutString)
+			Expect(putErr).NotTo(HaveOccurred())
+			Expect(c.GetRecursive("o2/control/tasks/0/wants")).To(BeEquivalentTo(recursivePutString))
+			Expect(standIn.snapshot()).NotTo(HaveKey("o2/control/tasks/0/wants/cpu"))
+		})
+	})
+
+	Context("when a write fails", func() {
+		It("should leave the store untouched if the transaction is rolled back", func() {
+			before := standIn.snapshot()
+			standIn.failOnKey = "o2/control/tasks/secondKey/1/type"
+			putErr := c.PutRecursive("o2/control/tasks", recursivePutMap)
+			Expect(putErr).To(HaveOccurred())
+			Expect(standIn.snapshot()).To(Equal(before))
+		})
+
+		It("should restore the previous subtree if a write spanning several transactions fails", func() {
+			large := make(Array, 100)
+			for i := range large {
+				large[i] = String(fmt.Sprintf("item %d", i))
+			}
+			before := standIn.snapshot()
+			standIn.failOnKey = "o2/control/tasks/90"
+			putErr := c.PutRecursive("o2/control/tasks", large)
+			Expect(putErr).To(HaveOccurred())
+			Expect(standIn.txnCount).To(BeNumerically(">", 1))
+			Expect(standIn.snapshot()).To(Equal(before))
+		})
+
+		It("should write large subtrees across several transactions", func() {
+			large := make(Array, 100)
+			for i := range large {
+				large[i] = String(fmt.Sprintf("item %d", i))
+			}
+			putErr := c.PutRecursive("o2/control/tasks", large)
+			Expect(putErr).NotTo(HaveOccurred())
+			marshalled, marshErr := yaml.Marshal(large)
+			Expect(marshErr).NotTo(HaveOccurred())
+			Expect(c.GetRecursiveYaml("o2/control/tasks")).To(Equal(marshalled))
+		})
+	})
+
+	Context("when watching a prefix", func() {
+		It("should notify about changed, added and deleted values under the prefix only", func() {
+			watchable, ok := c.(WatchableSource)
+			Expect(ok).To(BeTrue())
+			changes, cancel := watchable.Watch("o2/control/tasks")
+			defer cancel()
+
+			// Give the watch a moment to take its first snapshot
+			time.Sleep(100 * time.Millisecond)
+			standIn.set("o2/control/tasksExtra/name", "still unrelated")
+			standIn.set("o2/control/tasks/0/wants/cpu", "2")
+			Eventually(changes).Should(Receive(Equal(Change{Key: "o2/control/tasks/0/wants/cpu", Type: CT_Modified, Value: "2"})))
+
+			putErr := c.PutRecursive("o2/control/tasks/0/wants", Map{"cpu": String("2")})
+			Expect(putErr).NotTo(HaveOccurred())
+			Eventually(changes).Should(Receive(Equal(Change{Key: "o2/control/tasks/0/wants/memory", Type: CT_Deleted})))
+		})
+
+		It("should close the channel when cancelled", func() {
+			changes, cancel := c.(WatchableSource).Watch("o2/control")
+			cancel()
+			Eventually(changes, 3 * time.Second).Should(BeClosed())
+		})
+	})
+})
@@ -0,0 +1,320 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package configuration
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// DirSource is a configuration Source backed by a directory tree of YAML and JSON
+// files, so that a large configuration can be split in several files (e.g. one per
+// detector) and versioned.
+// Each directory maps onto a Map, and each name.yaml, name.yml or name.json file maps
+// onto the key name, with the contents of the file as value. Hidden files and files
+// with other extensions are ignored.
+// For example, with dir:///etc/o2 the key o2/control/tasks/0/name can be found in
+//   /etc/o2/o2/control.yaml      as tasks[0].name, or in
+//   /etc/o2/o2/control/tasks.yaml as [0].name
+// Writes go to the file which already holds the key. If there's none, a new YAML
+// file is created at the deepest existing directory.
+type DirSource struct {
+	path string
+}
+
+var dirSourceExtensions = []string{".yaml", ".yml", ".json"}
+
+func newDirSource(path string) (ds *DirSource, err error) {
+	var info os.FileInfo
+	info, err = os.Stat(path)
+	if err != nil {
+		return
+	}
+	if !info.IsDir() {
+		err = errors.New(fmt.Sprintf("%s is not a directory", path))
+		return
+	}
+	ds = &DirSource{path: path}
+	return
+}
+
+func (ds *DirSource) Get(key string) (value string, err error) {
+	var data Map
+	data, err = ds.load()
+	if err != nil {
+		return
+	}
+	return getValueAtPath(data, key)
+}
+
+func (ds *DirSource) GetKeysByPrefix(keyPrefix string, separator string) (value []string, err error) {
+	var data Map
+	data, err = ds.load()
+	if err != nil {
+		return
+	}
+	value = getKeysByPrefix(data, keyPrefix, separator)
+	return
+}
+
+func (ds *DirSource) GetRecursive(key string) (value Item, err error) {
+	var data Map
+	data, err = ds.load()
+	if err != nil {
+		return
+	}
+	value = getItemAtPath(data, key)
+	if value == nil {
+		err = errors.New(fmt.Sprintf("no value for key %s", key))
+	}
+	return
+}
+
+func (ds *DirSource) GetRecursiveYaml(key string) (value []byte, err error) {
+	var item Item
+	item, err = ds.GetRecursive(key)
+	if err != nil {
+		return
+	}
+	value, err = yaml.Marshal(item)
+	return
+}
+
+func (ds *DirSource) Exists(key string) (exists bool, err error) {
+	if len(splitPath(key)) == 0 {
+		return
+	}
+	var data Map
+	data, err = ds.load()
+	if err != nil {
+		return
+	}
+	exists = getItemAtPath(data, key) != nil
+	return
+}
+
+func (ds *DirSource) Put(key string, value string) (err error) {
+	return ds.PutRecursive(key, String(value))
+}
+
+func (ds *DirSource) PutRecursive(key string, value Item) (err error) {
+	if value == nil {
+		return errors.New("cannot put nil configuration item")
+	}
+
+	// We walk down the directory tree as far as the key allows, then we either
+	// write into the file we find there, or we create a new one.
+	components := splitPath(key)
+	dirPath := ds.path
+	for i, c := range components {
+		childDir := filepath.Join(dirPath, c)
+		if info, statErr := os.Stat(childDir); statErr == nil && info.IsDir() {
+			dirPath = childDir
+			continue
+		}
+
+		filePath, found := findConfigFile(dirPath, c)
+		if !found {
+			// Nothing holds this key yet, so we make a new file for it
+			var fileItem Item
+			fileItem, err = putItemAtPath(nil, strings.Join(components[i+1:], "/"), value.DeepCopy())
+			if err != nil {
+				return
+			}
+			return writeConfigFile(filepath.Join(dirPath, c + ".yaml"), fileItem)
+		}
+
+		var fileItem Item
+		fileItem, err = readConfigFile(filePath)
+		if err != nil {
+			return
+		}
+		fileItem, err = putItemAtPath(fileItem, strings.Join(components[i+1:], "/"), value.DeepCopy())
+		if err != nil {
+			return
+		}
+		return writeConfigFile(filePath, fileItem)
+	}
+
+	// The key maps onto a directory
+	if value.Type() != IT_Map {
+		return errors.New(fmt.Sprintf("cannot replace directory %s with a value which is not a map", dirPath))
+	}
+	return ds.putMapInDir(dirPath, value.Map())
+}
+
+func (ds *DirSource) PutRecursiveYaml(key string, value []byte) (err error) {
+	var cooked Item
+	cooked, err = ItemFromYaml(value)
+	if err != nil {
+		return
+	}
+	return ds.PutRecursive(key, cooked)
+}
+
+// putMapInDir replaces the contents of a directory with m, keeping the existing
+// split in subdirectories and files wherever the new keys allow it.
+func (ds *DirSource) putMapInDir(dirPath string, m Map) (err error) {
+	var entries []os.FileInfo
+	entries, err = ioutil.ReadDir(dirPath)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		name, ok := configKeyForEntry(entry)
+		if !ok {
+			continue
+		}
+		if _, keep := m[name]; keep {
+			continue
+		}
+		err = os.RemoveAll(filepath.Join(dirPath, entry.Name()))
+		if err != nil {
+			return
+		}
+	}
+
+	for k, v := range m {
+		childDir := filepath.Join(dirPath, k)
+		if info, statErr := os.Stat(childDir); statErr == nil && info.IsDir() && v.Type() == IT_Map {
+			err = ds.putMapInDir(childDir, v.Map())
+			if err != nil {
+				return
+			}
+			continue
+		}
+		if statErr := os.RemoveAll(childDir); statErr != nil {
+			return statErr
+		}
+
+		filePath, found := findConfigFile(dirPath, k)
+		if !found {
+			filePath = filepath.Join(dirPath, k + ".yaml")
+		}
+		err = writeConfigFile(filePath, v.DeepCopy())
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (ds *DirSource) load() (data Map, err error) {
+	return loadConfigDir(ds.path)
+}
+
+func loadConfigDir(dirPath string) (data Map, err error) {
+	var entries []os.FileInfo
+	entries, err = ioutil.ReadDir(dirPath)
+	if err != nil {
+		return
+	}
+
+	data = make(Map)
+	for _, entry := range entries {
+		name, ok := configKeyForEntry(entry)
+		if !ok {
+			continue
+		}
+		if _, exists := data[name]; exists {
+			err = errors.New(fmt.Sprintf("configuration key %s is defined more than once in %s", name, dirPath))
+			return
+		}
+
+		entryPath := filepath.Join(dirPath, entry.Name())
+		if entry.IsDir() {
+			data[name], err = loadConfigDir(entryPath)
+		} else {
+			data[name], err = readConfigFile(entryPath)
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// configKeyForEntry returns the configuration key a directory entry maps onto, if any.
+func configKeyForEntry(entry os.FileInfo) (name string, ok bool) {
+	if strings.HasPrefix(entry.Name(), ".") {
+		return
+	}
+	if entry.IsDir() {
+		return entry.Name(), true
+	}
+	ext := filepath.Ext(entry.Name())
+	for _, e := range dirSourceExtensions {
+		if ext == e {
+			return strings.TrimSuffix(entry.Name(), ext), true
+		}
+	}
+	return
+}
+
+func findConfigFile(dirPath string, name string) (filePath string, found bool) {
+	for _, ext := range dirSourceExtensions {
+		filePath = filepath.Join(dirPath, name + ext)
+		if info, err := os.Stat(filePath); err == nil && !info.IsDir() {
+			return filePath, true
+		}
+	}
+	return "", false
+}
+
+func readConfigFile(filePath string) (item Item, err error) {
+	var data []byte
+	data, err = ioutil.ReadFile(filePath)
+	if err != nil {
+		return
+	}
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return make(Map), nil
+	}
+	item, err = ItemFromYaml(data)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("bad configuration file %s: %s", filePath, err.Error()))
+	}
+	return
+}
+
+func writeConfigFile(filePath string, item Item) (err error) {
+	var data []byte
+	if filepath.Ext(filePath) == ".json" {
+		data, err = json.MarshalIndent(item, "", "    ")
+	} else {
+		data, err = yaml.Marshal(item)
+	}
+	if err != nil {
+		return
+	}
+	return ioutil.WriteFile(filePath, data, 0644)
+}
@@ -0,0 +1,278 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package configuration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// LayeredSource composes several configuration sources into a single logical
+// configuration.
+// Layers are ordered from lowest to highest precedence: reads resolve keys from the
+// topmost layer which defines them, and GetRecursive merges maps from all the layers
+// key by key (arrays and values are never merged, the topmost one wins).
+// Writes always go to the top layer.
+//
+// A LayeredSource is created by NewSource with a URI of the form
+//   layered://file:///path/to/defaults.yaml,consul://some-host:8500,file:///path/to/local.yaml
+// Keys are passed unchanged to every layer.
+type LayeredSource struct {
+	layers []Source
+}
+
+func newLayeredSource(uris string) (ls *LayeredSource, err error) {
+	ls = &LayeredSource{
+		layers: make([]Source, 0),
+	}
+	for _, uri := range strings.Split(uris, ",") {
+		uri = strings.TrimSpace(uri)
+		if len(uri) == 0 {
+			continue
+		}
+		if strings.HasPrefix(uri, "layered://") {
+			err = errors.New("layered configuration sources cannot be nested")
+			return
+		}
+		var layer Source
+		layer, err = NewSource(uri)
+		if err != nil {
+			err = fmt.Errorf("bad configuration layer %s: %s", uri, err.Error())
+			return
+		}
+		ls.layers = append(ls.layers, layer)
+	}
+	if len(ls.layers) == 0 {
+		err = errors.New("layered configuration source needs at least one layer")
+	}
+	return
+}
+
+func (ls *LayeredSource) top() Source {
+	return ls.layers[len(ls.layers) - 1]
+}
+
+func (ls *LayeredSource) Get(key string) (value string, err error) {
+	for i := len(ls.layers) - 1; i >= 0; i-- {
+		var exists bool
+		exists, err = ls.layers[i].Exists(key)
+		if err != nil {
+			return
+		}
+		if exists {
+			return ls.layers[i].Get(key)
+		}
+	}
+	err = errors.New(fmt.Sprintf("no value for key %s", key))
+	return
+}
+
+func (ls *LayeredSource) GetKeysByPrefix(keyPrefix string, separator string) (value []string, err error) {
+	keySet := make(map[string]struct{})
+	for _, layer := range ls.layers {
+		var keys []string
+		keys, err = layer.GetKeysByPrefix(keyPrefix, separator)
+		if err != nil {
+			return
+		}
+		for _, k := range keys {
+			keySet[k] = struct{}{}
+		}
+	}
+	value = make([]string, 0, len(keySet))
+	for k := range keySet {
+		value = append(value, k)
+	}
+	sort.Strings(value)
+	return
+}
+
+func (ls *LayeredSource) GetRecursive(key string) (value Item, err error) {
+	for _, layer := range ls.layers {
+		var item Item
+		item, err = layer.GetRecursive(key)
+		if err != nil {
+			// A layer which doesn't have this key is fine, anything else isn't
+			if exists, existsErr := layer.Exists(key); existsErr == nil && !exists {
+				err = nil
+				continue
+			}
+			return
+		}
+		// Some backends return an empty map for a missing key
+		if item == nil || (item.Type() == IT_Map && len(item.Map()) == 0) {
+			continue
+		}
+		value = mergeItems(value, item)
+	}
+	if value == nil {
+		err = errors.New(fmt.Sprintf("no value for key %s", key))
+	}
+	return
+}
+
+func (ls *LayeredSource) GetRecursiveYaml(key string) (value []byte, err error) {
+	var item Item
+	item, err = ls.GetRecursive(key)
+	if err != nil {
+		return
+	}
+	value, err = yaml.Marshal(item)
+	return
+}
+
+func (ls *LayeredSource) Exists(key string) (exists bool, err error) {
+	for i := len(ls.layers) - 1; i >= 0; i-- {
+		exists, err = ls.layers[i].Exists(key)
+		if err != nil || exists {
+			return
+		}
+	}
+	return
+}
+
+func (ls *LayeredSource) Put(key string, value string) error {
+	return ls.top().Put(key, value)
+}
+
+func (ls *LayeredSource) PutRecursive(key string, value Item) error {
+	return ls.top().PutRecursive(key, value)
+}
+
+func (ls *LayeredSource) PutRecursiveYaml(key string, value []byte) error {
+	return ls.top().PutRecursiveYaml(key, value)
+}
+
+// Watch implements WatchableSource by watching all the layers which support it, and
+// reporting the changes to the merged configuration under prefix. A change in a layer
+// which is shadowed by a higher layer is therefore not reported.
+func (ls *LayeredSource) Watch(prefix string) (<-chan Change, CancelFunc) {
+	changes := make(chan Change)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Any layer change only triggers a recomputation of the merged subtree
+	layerChanged := make(chan struct{}, 1)
+	layerCancels := make([]CancelFunc, 0)
+	for _, layer := range ls.layers {
+		wLayer, ok := layer.(WatchableSource)
+		if !ok {
+			continue
+		}
+		layerChanges, layerCancel := wLayer.Watch(prefix)
+		layerCancels = append(layerCancels, layerCancel)
+		go func() {
+			for range layerChanges {
+				select {
+				case layerChanged <- struct{}{}:
+				default:
+				}
+			}
+		}()
+	}
+	if len(layerCancels) == 0 {
+		cancel()
+		close(changes)
+		return changes, CancelFunc(cancel)
+	}
+
+	previous, err := ls.getLeaves(prefix)
+	if err != nil {
+		previous = make(map[string]string)
+	}
+	go func() {
+		defer close(changes)
+		defer func() {
+			for _, layerCancel := range layerCancels {
+				layerCancel()
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-layerChanged:
+				current, err := ls.getLeaves(prefix)
+				if err != nil {
+					// We'll try again on the next change
+					continue
+				}
+				for _, change := range diffLeaves(previous, current) {
+					select {
+					case changes <- change:
+					case <-ctx.Done():
+						return
+					}
+				}
+				previous = current
+			}
+		}
+	}()
+
+	return changes, CancelFunc(cancel)
+}
+
+func (ls *LayeredSource) getLeaves(prefix string) (leaves map[string]string, err error) {
+	leaves = make(map[string]string)
+	requestKey := strings.Trim(prefix, "/")
+
+	var item Item
+	item, err = ls.GetRecursive(requestKey)
+	if err != nil {
+		// A missing prefix is not an error, it just has no values
+		if exists, existsErr := ls.Exists(requestKey); existsErr == nil && !exists {
+			err = nil
+		}
+		return
+	}
+	flattenToLeaves(requestKey, item, leaves)
+	return
+}
+
+// mergeItems returns the result of overlaying upper on top of lower.
+// Maps are merged recursively, in all other cases upper replaces lower.
+// Neither lower nor upper are modified.
+func mergeItems(lower Item, upper Item) Item {
+	if lower == nil {
+		return upper.DeepCopy()
+	}
+	if upper == nil {
+		return lower.DeepCopy()
+	}
+	if lower.Type() != IT_Map || upper.Type() != IT_Map {
+		return upper.DeepCopy()
+	}
+
+	merged := lower.DeepCopy().Map()
+	for k, v := range upper.Map() {
+		merged[k] = mergeItems(merged[k], v)
+	}
+	return merged
+}
@@ -0,0 +1,118 @@
+package configuration_test
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/AliceO2Group/Control/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LayeredSource", func() {
+	var (
+		c            Source
+		err          error
+		standIn      *consulStandIn
+		server       *httptest.Server
+		defaultsPath string
+		localPath    string
+	)
+
+	BeforeEach(func() {
+		defaultsPath = *tmpDir + "/layer-defaults.yaml"
+		err = ioutil.WriteFile(defaultsPath, []byte(`o2:
+  control:
+    settings:
+      log_level: INFO
+      color: "true"
+      timeout: "10"
+    hosts:
+    - flp1
+    - flp2
+    defaults_only: foo
+`), 0644)
+		Expect(err).NotTo(HaveOccurred())
+
+		localPath = *tmpDir + "/layer-local.yaml"
+		err = ioutil.WriteFile(localPath, []byte(`o2:
+  control:
+    settings:
+      log_level: TRACE
+    hosts:
+    - localhost
+`), 0644)
+		Expect(err).NotTo(HaveOccurred())
+
+		standIn = newConsulStandIn()
+		standIn.kv["o2/control/settings/color"] = []byte("false")
+		standIn.kv["o2/control/settings/log_level"] = []byte("DEBUG")
+		standIn.kv["o2/control/site_only"] = []byte("bar")
+		server = httptest.NewServer(standIn)
+
+		c, err = NewSource("layered://file://" + defaultsPath +
+			",consul://" + strings.TrimPrefix(server.URL, "http://") +
+			",file://" + localPath)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should be of type *LayeredSource", func() {
+		_, ok := c.(*LayeredSource)
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should refuse nested or empty layered URIs", func() {
+		_, err = NewSource("layered://layered://file://" + defaultsPath)
+		Expect(err).To(HaveOccurred())
+		_, err = NewSource("layered://")
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("to get a subtree or value", func() {
+		It("should resolve single values by layer precedence", func() {
+			Expect(c.Get("o2/control/settings/log_level")).To(Equal("TRACE"))
+			Expect(c.Get("o2/control/settings/color")).To(Equal("false"))
+			Expect(c.Get("o2/control/settings/timeout")).To(Equal("10"))
+			Expect(c.Get("o2/control/site_only")).To(Equal("bar"))
+			Expect(c.Get("o2/control/defaults_only")).To(Equal("foo"))
+		})
+
+		It("should fail for keys defined in no layer", func() {
+			_, err = c.Get("o2/control/nowhere")
+			Expect(err).To(HaveOccurred())
+			Expect(c.Exists("o2/control/nowhere")).To(BeFalse())
+			Expect(c.Exists("o2/control/site_only")).To(BeTrue())
+		})
+
+		It("should merge subtrees map by map", func() {
+			Expect(c.GetRecursive("o2/control")).To(Equal(Map{
+				"settings": Map{
+					"log_level": String("TRACE"),
+					"color": String("false"),
+					"timeout": String("10"),
+				},
+				"hosts": Array{String("localhost")},
+				"defaults_only": String("foo"),
+				"site_only": String("bar"),
+			}))
+		})
+	})
+
+	Context("to add or update a value", func() {
+		It("should write to the top layer only", func() {
+			putErr := c.Put("o2/control/settings/color", "auto")
+			Expect(putErr).NotTo(HaveOccurred())
+			Expect(c.Get("o2/control/settings/color")).To(Equal("auto"))
+			Expect(standIn.snapshot()).To(HaveKeyWithValue("o2/control/settings/color", "false"))
+
+			local, localErr := NewSource("file://" + localPath)
+			Expect(localErr).NotTo(HaveOccurred())
+			Expect(local.Get("o2/control/settings/color")).To(Equal("auto"))
+		})
+	})
+})
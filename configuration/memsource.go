@@ -0,0 +1,283 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package configuration
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+// MemSource is a configuration Source which keeps everything in memory, mostly
+// useful for tests and for embedding.
+// Sources created with the same name (e.g. mem://test) share the same data within
+// a process, while each mem:// source without a name has its own private data.
+type MemSource struct {
+	mu   *sync.RWMutex
+	data Map
+}
+
+var (
+	memStoresMu sync.Mutex
+	memStores = make(map[string]*MemSource)
+)
+
+func newMemSource(name string) (ms *MemSource, err error) {
+	name = strings.Trim(name, "/")
+	if len(name) == 0 {
+		return &MemSource{mu: &sync.RWMutex{}, data: make(Map)}, nil
+	}
+
+	memStoresMu.Lock()
+	defer memStoresMu.Unlock()
+	if shared, ok := memStores[name]; ok {
+		return shared, nil
+	}
+	ms = &MemSource{mu: &sync.RWMutex{}, data: make(Map)}
+	memStores[name] = ms
+	return
+}
+
+func (ms *MemSource) Get(key string) (value string, err error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	return getValueAtPath(ms.data, key)
+}
+
+func (ms *MemSource) GetKeysByPrefix(keyPrefix string, separator string) (value []string, err error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	return getKeysByPrefix(ms.data, keyPrefix, separator), nil
+}
+
+func (ms *MemSource) GetRecursive(key string) (value Item, err error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	item := getItemAtPath(ms.data, key)
+	if item == nil {
+		err = errors.New(fmt.Sprintf("no value for key %s", key))
+		return
+	}
+	value = item.DeepCopy()
+	return
+}
+
+func (ms *MemSource) GetRecursiveYaml(key string) (value []byte, err error) {
+	var item Item
+	item, err = ms.GetRecursive(key)
+	if err != nil {
+		return
+	}
+	value, err = yaml.Marshal(item)
+	return
+}
+
+func (ms *MemSource) Exists(key string) (exists bool, err error) {
+	if len(splitPath(key)) == 0 {
+		return
+	}
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+	exists = getItemAtPath(ms.data, key) != nil
+	return
+}
+
+func (ms *MemSource) Put(key string, value string) (err error) {
+	return ms.PutRecursive(key, String(value))
+}
+
+func (ms *MemSource) PutRecursive(key string, value Item) (err error) {
+	if value == nil {
+		return errors.New("cannot put nil configuration item")
+	}
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	var root Item
+	root, err = putItemAtPath(ms.data, key, value.DeepCopy())
+	if err != nil {
+		return
+	}
+	if root.Type() != IT_Map {
+		return errors.New("top level configuration item should be a map")
+	}
+	ms.data = root.Map()
+	return
+}
+
+func (ms *MemSource) PutRecursiveYaml(key string, value []byte) (err error) {
+	var cooked Item
+	cooked, err = ItemFromYaml(value)
+	if err != nil {
+		return
+	}
+	return ms.PutRecursive(key, cooked)
+}
+
+// Keys for the in-memory and directory backends are slash-separated paths, in which
+// array items can be addressed either as path components (tasks/0/name, as in Consul)
+// or with an index suffix (tasks[0]/name, as in YAML files).
+var pathComponentIndex = regexp.MustCompile(`^(.*)\[(\d+)\]$`)
+
+func splitPath(key string) (components []string) {
+	key = strings.Trim(key, "/")
+	if len(key) == 0 {
+		return []string{}
+	}
+	components = make([]string, 0)
+	for _, c := range strings.Split(key, "/") {
+		if match := pathComponentIndex.FindStringSubmatch(c); match != nil {
+			if len(match[1]) != 0 {
+				components = append(components, match[1])
+			}
+			components = append(components, match[2])
+			continue
+		}
+		components = append(components, c)
+	}
+	return
+}
+
+func getChild(item Item, component string) Item {
+	if item == nil {
+		return nil
+	}
+	switch item.Type() {
+	case IT_Map:
+		return item.Map()[component]
+	case IT_Array:
+		index, err := strconv.Atoi(component)
+		if err != nil || index < 0 || index >= len(item.Array()) {
+			return nil
+		}
+		return item.Array()[index]
+	}
+	return nil
+}
+
+func getItemAtPath(root Item, key string) (item Item) {
+	item = root
+	for _, c := range splitPath(key) {
+		item = getChild(item, c)
+		if item == nil {
+			return
+		}
+	}
+	return
+}
+
+func getValueAtPath(root Item, key string) (value string, err error) {
+	item := getItemAtPath(root, key)
+	if item == nil {
+		err = errors.New(fmt.Sprintf("no value for key %s", key))
+		return
+	}
+	switch item.Type() {
+	case IT_Map:
+		err = errors.New(fmt.Sprintf("found map at key %s but string was expected", key))
+	case IT_Array:
+		err = errors.New(fmt.Sprintf("found array at key %s but string was expected", key))
+	default:
+		value = item.Value()
+	}
+	return
+}
+
+// putItemAtPath sets value at key under root, creating intermediate maps as needed,
+// and returns the (possibly new) root.
+func putItemAtPath(root Item, key string, value Item) (newRoot Item, err error) {
+	components := splitPath(key)
+	if len(components) == 0 {
+		return value, nil
+	}
+	return putItemAtComponents(root, components, value, key)
+}
+
+func putItemAtComponents(item Item, components []string, value Item, key string) (newItem Item, err error) {
+	if len(components) == 0 {
+		return value, nil
+	}
+	if item == nil {
+		item = make(Map)
+	}
+
+	c := components[0]
+	switch item.Type() {
+	case IT_Map:
+		m := item.Map()
+		m[c], err = putItemAtComponents(m[c], components[1:], value, key)
+		return m, err
+	case IT_Array:
+		a := item.Array()
+		index, atoiErr := strconv.Atoi(c)
+		if atoiErr != nil || index < 0 || index > len(a) {
+			err = errors.New(fmt.Sprintf("bad array index %s in key %s", c, key))
+			return item, err
+		}
+		if index == len(a) {
+			a = append(a, nil)
+		}
+		a[index], err = putItemAtComponents(a[index], components[1:], value, key)
+		return a, err
+	}
+	err = errors.New(fmt.Sprintf("found string at key %s but map was expected", key))
+	return item, err
+}
+
+// getKeysByPrefix returns the keys of all the values under root which start with
+// keyPrefix, in the same form as Consul would. If separator is not empty, keys are
+// truncated after the first separator following the prefix, as with Consul.
+func getKeysByPrefix(root Map, keyPrefix string, separator string) (keys []string) {
+	keyPrefix = strings.TrimLeft(keyPrefix, "/")
+	leaves := make(map[string]string)
+	flattenToLeaves("", root, leaves)
+
+	keySet := make(map[string]struct{})
+	for k := range leaves {
+		if !strings.HasPrefix(k, keyPrefix) {
+			continue
+		}
+		if len(separator) != 0 {
+			rest := strings.TrimPrefix(k, keyPrefix)
+			if i := strings.Index(rest, separator); i >= 0 {
+				k = keyPrefix + rest[:i + len(separator)]
+			}
+		}
+		keySet[k] = struct{}{}
+	}
+
+	keys = make([]string, 0, len(keySet))
+	for k := range keySet {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return
+}
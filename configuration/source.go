@@ -24,7 +24,8 @@
 
 // Package configuration defines the Source interface as the
 // main access point to O² Configuration backends.
-// Consul and YAML backends are also provided.
+// Consul, YAML file, YAML directory tree and in-memory backends are also
+// provided, as well as a layered Source which composes several backends.
 package configuration
 
 import (
@@ -48,13 +49,22 @@ type Source interface {
 }
 
 func NewSource(uri string) (configuration Source, err error) {
-	if strings.HasPrefix(uri, "consul://") {
+	if strings.HasPrefix(uri, "layered://") {
+		configuration, err = newLayeredSource(strings.TrimPrefix(uri, "layered://"))
+		return
+	} else if strings.HasPrefix(uri, "consul://") {
 		configuration, err = newConsulSource(strings.TrimPrefix(uri, "consul://"))
 		return
 	} else if strings.HasPrefix(uri, "file://") &&
 		(strings.HasSuffix(uri, ".yaml") || strings.HasSuffix(uri, ".json")) {
 		configuration, err = newYamlSource(uri)
 		return
+	} else if strings.HasPrefix(uri, "dir://") {
+		configuration, err = newDirSource(strings.TrimPrefix(uri, "dir://"))
+		return
+	} else if strings.HasPrefix(uri, "mem://") {
+		configuration, err = newMemSource(strings.TrimPrefix(uri, "mem://"))
+		return
 	}
 
 	err = errors.New("bad URI for configuration source")
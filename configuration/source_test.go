@@ -1,6 +1,9 @@
 package configuration_test
 
 import (
+	"io/ioutil"
+	"os"
+
 	. "github.com/AliceO2Group/Control/configuration"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -233,5 +236,82 @@ var _ = Describe("Source", func() {
 
 			DoConfigurationTests()
 		})
+
+		Context("with in-memory backend", func() {
+			BeforeEach(func() {
+				c, err = NewSource("mem://")
+				if err != nil {
+					return
+				}
+				var data []byte
+				data, err = ioutil.ReadFile("./" + configFile)
+				if err != nil {
+					return
+				}
+				err = c.PutRecursiveYaml("", data)
+			})
+
+			It("should be of type *MemSource", func() {
+				_, ok := c.(*MemSource)
+				Expect(ok).To(Equal(true))
+			})
+
+			It("should share data between sources with the same name", func() {
+				first, firstErr := NewSource("mem://shared")
+				Expect(firstErr).NotTo(HaveOccurred())
+				second, secondErr := NewSource("mem://shared")
+				Expect(secondErr).NotTo(HaveOccurred())
+				Expect(first.Put("foo/bar", "baz")).To(Succeed())
+				Expect(second.Get("foo/bar")).To(Equal("baz"))
+				Expect(c.Exists("foo/bar")).To(BeFalse())
+			})
+
+			DoConfigurationTests()
+		})
+
+		Context("with YAML directory tree backend", func() {
+			var dirPath string
+
+			BeforeEach(func() {
+				dirPath, err = ioutil.TempDir(*tmpDir, "dirsource")
+				if err != nil {
+					return
+				}
+				c, err = NewSource("dir://" + dirPath)
+				if err != nil {
+					return
+				}
+				var data []byte
+				data, err = ioutil.ReadFile("./" + configFile)
+				if err != nil {
+					return
+				}
+				err = c.PutRecursiveYaml("", data)
+			})
+
+			It("should be of type *DirSource", func() {
+				_, ok := c.(*DirSource)
+				Expect(ok).To(Equal(true))
+			})
+
+			It("should map directories and files onto the key hierarchy", func() {
+				Expect(os.MkdirAll(dirPath + "/site/detectors", 0755)).To(Succeed())
+				Expect(ioutil.WriteFile(dirPath + "/site/detectors/tpc.yaml", []byte("flps:\n- flp1\n- flp2\n"), 0644)).To(Succeed())
+				Expect(ioutil.WriteFile(dirPath + "/site/detectors/its.json", []byte(`{"flps": ["flp3"]}`), 0644)).To(Succeed())
+				Expect(ioutil.WriteFile(dirPath + "/site/detectors/README.md", []byte("not configuration"), 0644)).To(Succeed())
+
+				Expect(c.Get("site/detectors/tpc/flps/1")).To(Equal("flp2"))
+				Expect(c.Get("site/detectors/its/flps[0]")).To(Equal("flp3"))
+				Expect(c.GetKeysByPrefix("site/detectors/", "/")).To(Equal([]string{"site/detectors/its/", "site/detectors/tpc/"}))
+				Expect(c.Exists("site/detectors/README")).To(BeFalse())
+
+				Expect(c.Put("site/detectors/its/flps[0]", "flp4")).To(Succeed())
+				Expect(c.Put("site/detectors/mch/flps", "flp5")).To(Succeed())
+				Expect(ioutil.ReadFile(dirPath + "/site/detectors/its.json")).To(ContainSubstring("flp4"))
+				Expect(ioutil.ReadFile(dirPath + "/site/detectors/mch.yaml")).To(ContainSubstring("flp5"))
+			})
+
+			DoConfigurationTests()
+		})
 	})
 })
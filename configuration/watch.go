@@ -0,0 +1,125 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package configuration
+
+import (
+	"sort"
+	"strconv"
+	"time"
+)
+
+type ChangeType int
+const (
+	CT_Modified ChangeType = iota
+	CT_Deleted
+)
+
+func (ct ChangeType) String() string {
+	switch ct {
+	case CT_Modified:
+		return "modified"
+	case CT_Deleted:
+		return "deleted"
+	}
+	return "unknown"
+}
+
+// Change describes a single value added, modified or deleted under a watched prefix.
+// Keys are always slash-separated, with array indices as path components, e.g.
+// o2/control/tasks/0/name, regardless of the backend.
+type Change struct {
+	Key   string
+	Type  ChangeType
+	Value string
+}
+
+type CancelFunc func()
+
+// WatchableSource is implemented by configuration backends which can notify about
+// changes to their contents.
+// Watch starts watching all the values under prefix, and returns a channel on which a
+// Change is sent for every value which is added, modified or deleted after the call.
+// The channel is closed when the watch is cancelled, or if the source cannot be watched.
+type WatchableSource interface {
+	Watch(prefix string) (<-chan Change, CancelFunc)
+}
+
+const (
+	watchRetryMin = 1 * time.Second
+	watchRetryMax = 30 * time.Second
+)
+
+// flattenToLeaves collects all the values of a configuration subtree into a
+// key -> value map.
+func flattenToLeaves(key string, item Item, leaves map[string]string) {
+	if item == nil {
+		return
+	}
+	joinKey := func(k string) string {
+		if len(key) == 0 {
+			return k
+		}
+		return key + "/" + k
+	}
+	switch item.Type() {
+	case IT_Value:
+		leaves[key] = item.Value()
+	case IT_Map:
+		for k, v := range item.Map() {
+			flattenToLeaves(joinKey(k), v, leaves)
+		}
+	case IT_Array:
+		for i, v := range item.Array() {
+			flattenToLeaves(joinKey(strconv.Itoa(i)), v, leaves)
+		}
+	}
+}
+
+// diffLeaves returns the changes needed to go from previous to current, sorted by key.
+func diffLeaves(previous map[string]string, current map[string]string) (changes []Change) {
+	changes = make([]Change, 0)
+	for k, v := range current {
+		if oldValue, ok := previous[k]; !ok || oldValue != v {
+			changes = append(changes, Change{Key: k, Type: CT_Modified, Value: v})
+		}
+	}
+	for k := range previous {
+		if _, ok := current[k]; !ok {
+			changes = append(changes, Change{Key: k, Type: CT_Deleted})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return
+}
+
+func nextWatchRetry(retry time.Duration) time.Duration {
+	retry *= 2
+	if retry > watchRetryMax {
+		retry = watchRetryMax
+	}
+	return retry
+}
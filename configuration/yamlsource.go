@@ -28,13 +28,20 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/fsnotify/fsnotify"
 	"gopkg.in/yaml.v2"
 )
 
+// Editors and tools often write a file in several steps, so we wait a bit after
+// the last filesystem event before reading it again
+const yamlWatchSettleTime = 200 * time.Millisecond
+
 type YamlSource struct {
 	uri  string
 	data Map
@@ -445,6 +452,114 @@ func (yc *YamlSource) Exists(key string) (exists bool, err error) {
 	return
 }
 
+// Watch implements WatchableSource by watching the YAML file with fsnotify.
+// The parent directory is watched rather than the file itself, so that changes
+// are still picked up if the file is replaced (e.g. by an editor or by git).
+func (yc *YamlSource) Watch(prefix string) (<-chan Change, CancelFunc) {
+	changes := make(chan Change)
+	done := make(chan struct{})
+	cancel := func() {
+		select {
+		case <-done:
+		default:
+			close(done)
+		}
+	}
+
+	path, err := filepath.Abs(pathForUri(yc.uri))
+	if err != nil {
+		close(changes)
+		return changes, cancel
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		close(changes)
+		return changes, cancel
+	}
+	err = watcher.Add(filepath.Dir(path))
+	if err != nil {
+		watcher.Close()
+		close(changes)
+		return changes, cancel
+	}
+
+	// We read the file with a separate instance, in order not to interfere with
+	// yc.data while the watch is running.
+	reader := &YamlSource{uri: yc.uri}
+	previous, err := reader.getLeaves(prefix)
+	if err != nil {
+		previous = make(map[string]string)
+	}
+
+	go func() {
+		defer close(changes)
+		defer watcher.Close()
+
+		var settle <-chan time.Time
+		for {
+			select {
+			case <-done:
+				return
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != path {
+					continue
+				}
+				settle = time.After(yamlWatchSettleTime)
+			case _, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+			case <-settle:
+				settle = nil
+				current, err := reader.getLeaves(prefix)
+				if err != nil {
+					// Most likely the file is being written or is temporarily invalid,
+					// we'll try again on the next event
+					continue
+				}
+				for _, change := range diffLeaves(previous, current) {
+					select {
+					case changes <- change:
+					case <-done:
+						return
+					}
+				}
+				previous = current
+			}
+		}
+	}()
+
+	return changes, cancel
+}
+
+func (yc *YamlSource) getLeaves(prefix string) (leaves map[string]string, err error) {
+	leaves = make(map[string]string)
+	requestKey := strings.TrimRight(yamlFormatKey(prefix), "/")
+	if len(requestKey) == 0 {
+		err = yc.refresh()
+		if err != nil {
+			return
+		}
+		flattenToLeaves("", yc.data, leaves)
+		return
+	}
+
+	var item Item
+	item, err = yc.GetRecursive(requestKey)
+	if err != nil {
+		// A missing prefix is not an error, it just has no values
+		if exists, existsErr := yc.Exists(requestKey); existsErr == nil && !exists {
+			err = nil
+		}
+		return
+	}
+	flattenToLeaves(requestKey, item, leaves)
+	return
+}
+
 func yamlFormatKey(key string) (consulKey string) {
 	// Trim leading slashes
 	consulKey = strings.TrimLeft(key, "/")
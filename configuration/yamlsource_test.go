@@ -0,0 +1,48 @@
+package configuration_test
+
+import (
+	"io/ioutil"
+	"time"
+
+	. "github.com/AliceO2Group/Control/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("YamlSource", func() {
+	var (
+		c        Source
+		err      error
+		yamlPath string
+	)
+
+	BeforeEach(func() {
+		yamlPath = *tmpDir + "/watched.yaml"
+		err = ioutil.WriteFile(yamlPath, []byte("o2:\n  control:\n    tasks:\n    - name: sampler\n      wants:\n        cpu: 1\n  other: stuff\n"), 0644)
+		Expect(err).NotTo(HaveOccurred())
+		c, err = NewSource("file://" + yamlPath)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when watching a prefix", func() {
+		It("should notify about changes to the file under the prefix", func() {
+			watchable, ok := c.(WatchableSource)
+			Expect(ok).To(BeTrue())
+			changes, cancel := watchable.Watch("o2/control")
+			defer cancel()
+
+			err = ioutil.WriteFile(yamlPath, []byte("o2:\n  control:\n    tasks:\n    - name: sampler\n      wants:\n        memory: 256\n  other: more stuff\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(changes, 3 * time.Second).Should(Receive(Equal(Change{Key: "o2/control/tasks/0/wants/cpu", Type: CT_Deleted})))
+			Eventually(changes, 3 * time.Second).Should(Receive(Equal(Change{Key: "o2/control/tasks/0/wants/memory", Type: CT_Modified, Value: "256"})))
+			Consistently(changes, 500 * time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("should close the channel when cancelled", func() {
+			changes, cancel := c.(WatchableSource).Watch("o2/control")
+			cancel()
+			Eventually(changes, 3 * time.Second).Should(BeClosed())
+		})
+	})
+})
@@ -51,6 +51,7 @@ func setDefaults() error {
 	viper.SetDefault("controlPort", 47102)
 	viper.SetDefault("coreConfigurationUri", "consul://127.0.0.1:8500") //TODO: TBD
 	viper.SetDefault("defaultRepo", "github.com/AliceO2Group/ControlWorkflows")
+	viper.SetDefault("deploymentTimeout", "90s")
 	viper.SetDefault("executor", env("EXEC_BINARY", filepath.Join(exeDir, "o2control-executor")))
 	viper.SetDefault("executorCPU", envFloat("EXEC_CPU", "0.01"))
 	viper.SetDefault("executorMemory", envFloat("EXEC_MEMORY", "64"))
@@ -58,6 +59,7 @@ func setDefaults() error {
 	viper.SetDefault("mesosApiTimeout", envDuration("MESOS_CONNECT_TIMEOUT", "20s"))
 	viper.SetDefault("mesosAuthMode", env("AUTH_MODE", ""))
 	viper.SetDefault("mesosCheckpoint", true)
+	viper.SetDefault("mesosCommandTimeout", "45s")
 	viper.SetDefault("mesosCodec", codec{Codec: codecs.ByMediaType[codecs.MediaTypeProtobuf]})
 	viper.SetDefault("mesosCompression", false)
 	viper.SetDefault("mesosCredentials.username", env("AUTH_USER", ""))
@@ -83,6 +85,8 @@ func setDefaults() error {
 	viper.SetDefault("metrics.port", envInt("PORT0", "64009"))
 	viper.SetDefault("metrics.path", env("METRICS_API_PATH", "/metrics"))
 	viper.SetDefault("repositoriesPath", "/etc/aliecs.d/repos")
+	viper.SetDefault("runNumberProviderUri", "")
+	viper.SetDefault("secretStoreUri", "file:///etc/aliecs.d/secrets")
 	viper.SetDefault("summaryMetrics", false)
 	viper.SetDefault("verbose", false)
 	viper.SetDefault("veryVerbose", false)
@@ -94,6 +98,7 @@ func setDefaults() error {
 func setFlags() error {
 	pflag.Int("controlPort", viper.GetInt("controlPort"), "Port of control server")
 	pflag.String("coreConfigurationUri", viper.GetString("coreConfigurationUri"), "URI of the Consul server or YAML configuration file, used for core configuration.")
+	pflag.Duration("deploymentTimeout", viper.GetDuration("deploymentTimeout"), "Time allowed for all tasks of a workflow to be deployed and become active")
 	pflag.String("executor", viper.GetString("executor"), "Full path to executor binary on Mesos agents")
 	pflag.Float64("executorCPU", viper.GetFloat64("executorCPU"), "CPU resources to consume per-executor")
 	pflag.Float64("executorMemory", viper.GetFloat64("executorMemory"), "Memory resources (MB) to consume per-executor")
@@ -101,6 +106,7 @@ func setFlags() error {
 	pflag.Duration("mesosApiTimeout", viper.GetDuration("mesosApiTimeout"), "Mesos scheduler API connection timeout")
 	pflag.String("mesosAuthMode", viper.GetString("mesosAuthMode"), "Method to use for Mesos authentication; specify '"+AuthModeBasic+"' for simple HTTP authentication")
 	pflag.Bool("mesosCheckpoint", viper.GetBool("mesosCheckpoint"), "Enable/disable agent checkpointing for framework tasks (recover from agent failure)")
+	pflag.Duration("mesosCommandTimeout", viper.GetDuration("mesosCommandTimeout"), "Time to wait for executors to respond to a control command")
 	pflag.Bool("mesosCompression", viper.GetBool("mesosCompression"), "When true attempt to use compression for HTTP streams.")
 	pflag.String("mesosExecutorImage", viper.GetString("mesosExecutorImage"), "Name of the docker image to run the executor")
 	pflag.Duration("mesosFailoverTimeout", viper.GetDuration("mesosFailoverTimeout"), "Framework failover timeout (recover from scheduler failure)")
@@ -121,8 +127,10 @@ func setFlags() error {
 	pflag.String("metrics.address", viper.GetString("metrics.address"), "IP of metrics server")
 	pflag.Int("metrics.port", viper.GetInt("metrics.port"), "Port of metrics server (listens on server.address)")
 	pflag.String("metrics.path", viper.GetString("metrics.path"), "URI path to metrics endpoint")
+	pflag.String("secretStoreUri", viper.GetString("secretStoreUri"), "URI of the store used to resolve secret:// references in task classes (file:///path/to/dir, one file per secret)")
 	pflag.Bool("summaryMetrics", viper.GetBool("summaryMetrics"), "Collect summary metrics for tasks launched per-offer-cycle, offer processing time, etc.")
 	pflag.String("repositoriesPath", viper.GetString("repositoriesPath"), "Path to git-managed configuration repositories")
+	pflag.String("runNumberProviderUri", viper.GetString("runNumberProviderUri"), "URI of the run number provider (file://*, http[s]://* run registry or local://), overrides the global configuration run number counter")
 	pflag.Bool("verbose", viper.GetBool("verbose"), "Verbose logging")
 	pflag.Bool("veryVerbose", viper.GetBool("veryVerbose"), "Very verbose logging")
 	pflag.String("globalConfigurationUri", viper.GetString("globalConfigurationUri"), "URI of the Consul server or YAML configuration file, used for global configuration.")
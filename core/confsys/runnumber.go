@@ -0,0 +1,310 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package confsys
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AliceO2Group/Control/configuration"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/sys/unix"
+)
+
+// RunNumberProvider hands out run numbers. Every call must return a number
+// that was never returned before, even across several cores sharing the same
+// provider and across restarts.
+type RunNumberProvider interface {
+	NewRunNumber() (runNumber uint32, err error)
+}
+
+// NewRunNumberProvider builds a provider from a URI:
+//   file:///path/to/rn/file       counter file, shared by all cores on this host
+//   http[s]://host:port/path      external run registry service
+//   local://                      in-process counter, for tests only
+func NewRunNumberProvider(uri string) (rnp RunNumberProvider, err error) {
+	var u *url.URL
+	u, err = url.Parse(uri)
+	if err != nil {
+		return
+	}
+	switch u.Scheme {
+	case "file":
+		rnp = NewFileRunNumberProvider(u.Host + u.Path)
+	case "http", "https":
+		rnp = NewHttpRunNumberProvider(uri)
+	case "local":
+		rnp = NewLocalRunNumberProvider(0)
+	default:
+		err = errors.New(fmt.Sprintf("%s: run number provider URI could not be parsed (expecting file://*, http[s]://* or local://)", uri))
+	}
+	return
+}
+
+const consulRunNumberRetries = 5
+
+// ConsulRunNumberProvider increments a counter key with Consul's check-and-set,
+// retrying if another core got there first.
+type ConsulRunNumberProvider struct {
+	src *configuration.ConsulSource
+	key string
+}
+
+func NewConsulRunNumberProvider(src *configuration.ConsulSource, key string) *ConsulRunNumberProvider {
+	return &ConsulRunNumberProvider{src: src, key: key}
+}
+
+func (p *ConsulRunNumberProvider) NewRunNumber() (runNumber uint32, err error) {
+	for i := 0; i < consulRunNumberRetries; i++ {
+		runNumber, err = p.src.GetNextUInt32(p.key)
+		if err == nil {
+			return
+		}
+		time.Sleep(time.Duration(i + 1) * 50 * time.Millisecond)
+	}
+	err = errors.New(fmt.Sprintf("cannot allocate run number from %s: %s", p.key, err.Error()))
+	return
+}
+
+// FileRunNumberProvider keeps the last allocated run number in a plain text
+// file. Allocations take an exclusive flock on a sibling .lock file, and the
+// new value is written to a temporary file, synced and renamed over the old
+// one, so that neither concurrent cores nor a crash can yield a duplicate.
+type FileRunNumberProvider struct {
+	path string
+}
+
+func NewFileRunNumberProvider(path string) *FileRunNumberProvider {
+	return &FileRunNumberProvider{path: path}
+}
+
+func (p *FileRunNumberProvider) NewRunNumber() (runNumber uint32, err error) {
+	var rnf string
+	rnf, err = homedir.Expand(p.path) // Resolve ~ into home dir
+	if err != nil {
+		return
+	}
+	dir := filepath.Dir(rnf)
+	err = os.MkdirAll(dir, 0755)
+	if err != nil {
+		return
+	}
+
+	var lockFile *os.File
+	lockFile, err = os.OpenFile(rnf + ".lock", os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return
+	}
+	defer lockFile.Close()
+	err = unix.Flock(int(lockFile.Fd()), unix.LOCK_EX)
+	if err != nil {
+		return
+	}
+	defer unix.Flock(int(lockFile.Fd()), unix.LOCK_UN)
+
+	var last uint32
+	last, err = readRunNumberFile(rnf)
+	if err != nil {
+		return
+	}
+	if last == math.MaxUint32 {
+		err = errors.New(fmt.Sprintf("run number file %s overflow", rnf))
+		return
+	}
+	runNumber = last + 1
+
+	err = writeFileSynced(rnf, []byte(strconv.FormatUint(uint64(runNumber), 10) + "\n"), 0644)
+	if err != nil {
+		runNumber = 0
+	}
+	return
+}
+
+func readRunNumberFile(rnf string) (runNumber uint32, err error) {
+	var fi os.FileInfo
+	fi, err = os.Stat(rnf)
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return
+	}
+	// Older versions wrote this file with mode 0, fix it up so we can read it
+	if fi.Mode().Perm() == 0 {
+		err = os.Chmod(rnf, 0644)
+		if err != nil {
+			return
+		}
+	}
+
+	var raw []byte
+	raw, err = ioutil.ReadFile(rnf)
+	if err != nil {
+		return
+	}
+	trimmed := strings.TrimSpace(string(raw[:]))
+	if len(trimmed) == 0 {
+		return 0, nil
+	}
+	var rn64 uint64
+	rn64, err = strconv.ParseUint(trimmed, 10, 32)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("bad run number file %s: %s", rnf, err.Error()))
+		return
+	}
+	runNumber = uint32(rn64)
+	return
+}
+
+// writeFileSynced replaces path with data so that after a crash the file holds
+// either the old or the new contents, never a partial write.
+func writeFileSynced(path string, data []byte, perm os.FileMode) (err error) {
+	dir := filepath.Dir(path)
+	var tmp *os.File
+	tmp, err = ioutil.TempFile(dir, "." + filepath.Base(path) + ".tmp")
+	if err != nil {
+		return
+	}
+	tmpPath := tmp.Name()
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmpPath)
+		}
+	}()
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if err == nil {
+		err = tmp.Chmod(perm)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return
+	}
+
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return
+	}
+
+	// The rename itself is only durable once the directory is synced
+	var d *os.File
+	d, err = os.Open(dir)
+	if err != nil {
+		return
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+const httpRunNumberTimeout = 10 * time.Second
+
+// HttpRunNumberProvider requests run numbers from an external run registry.
+// Each allocation is a POST to the registry URL, which must reply 200 OK with
+// a JSON body such as {"runNumber": 123}.
+type HttpRunNumberProvider struct {
+	url    string
+	client *http.Client
+}
+
+func NewHttpRunNumberProvider(url string) *HttpRunNumberProvider {
+	return &HttpRunNumberProvider{
+		url:    url,
+		client: &http.Client{Timeout: httpRunNumberTimeout},
+	}
+}
+
+type runRegistryReply struct {
+	RunNumber *uint32 `json:"runNumber"`
+}
+
+func (p *HttpRunNumberProvider) NewRunNumber() (runNumber uint32, err error) {
+	var resp *http.Response
+	resp, err = p.client.Post(p.url, "application/json", nil)
+	if err != nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	var body []byte
+	body, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return
+	}
+	if resp.StatusCode != http.StatusOK {
+		err = errors.New(fmt.Sprintf("run registry %s replied %s: %s", p.url, resp.Status, strings.TrimSpace(string(body))))
+		return
+	}
+
+	var reply runRegistryReply
+	err = json.Unmarshal(body, &reply)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("bad reply from run registry %s: %s", p.url, err.Error()))
+		return
+	}
+	if reply.RunNumber == nil || *reply.RunNumber == 0 {
+		err = errors.New(fmt.Sprintf("run registry %s did not return a valid run number", p.url))
+		return
+	}
+	runNumber = *reply.RunNumber
+	return
+}
+
+// LocalRunNumberProvider is an in-process counter. It keeps nothing across
+// restarts, so it is only meant as a stand-in for tests and development.
+type LocalRunNumberProvider struct {
+	mu   sync.Mutex
+	last uint32
+}
+
+func NewLocalRunNumberProvider(last uint32) *LocalRunNumberProvider {
+	return &LocalRunNumberProvider{last: last}
+}
+
+func (p *LocalRunNumberProvider) NewRunNumber() (runNumber uint32, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.last == math.MaxUint32 {
+		err = errors.New("local run number overflow")
+		return
+	}
+	p.last++
+	runNumber = p.last
+	return
+}
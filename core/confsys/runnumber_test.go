@@ -0,0 +1,197 @@
+package confsys_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/AliceO2Group/Control/core/confsys"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// runRegistryStandIn mimics an external run registry: every POST allocates
+// the next run number.
+type runRegistryStandIn struct {
+	mu      sync.Mutex
+	last    uint32
+	failing bool
+}
+
+func (r *runRegistryStandIn) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.failing {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprint(w, "registry offline")
+		return
+	}
+	r.last++
+	fmt.Fprintf(w, `{"runNumber": %d}`, r.last)
+}
+
+func allocateConcurrently(newProvider func() confsys.RunNumberProvider, workers int, perWorker int) map[uint32]int {
+	seen := make(map[uint32]int)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer GinkgoRecover()
+			defer wg.Done()
+			// Each worker gets its own provider, like separate cores would
+			rnp := newProvider()
+			for j := 0; j < perWorker; j++ {
+				rn, err := rnp.NewRunNumber()
+				Expect(err).NotTo(HaveOccurred())
+				mu.Lock()
+				seen[rn]++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return seen
+}
+
+var _ = Describe("run number providers", func() {
+	Describe("file provider", func() {
+		var (
+			dir string
+			rnf string
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "confsys-test")
+			Expect(err).NotTo(HaveOccurred())
+			rnf = filepath.Join(dir, "counter", "runcounter.txt")
+		})
+
+		AfterEach(func() {
+			_ = os.RemoveAll(dir)
+		})
+
+		It("should start from 1 and persist the counter", func() {
+			rnp := confsys.NewFileRunNumberProvider(rnf)
+			for i := uint32(1); i <= 3; i++ {
+				rn, err := rnp.NewRunNumber()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rn).To(Equal(i))
+			}
+			raw, err := ioutil.ReadFile(rnf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw)).To(Equal("3\n"))
+
+			fi, err := os.Stat(rnf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fi.Mode().Perm()).To(Equal(os.FileMode(0644)))
+		})
+
+		It("should continue from an existing counter file", func() {
+			Expect(os.MkdirAll(filepath.Dir(rnf), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(rnf, []byte("41"), 0644)).To(Succeed())
+			rn, err := confsys.NewFileRunNumberProvider(rnf).NewRunNumber()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rn).To(Equal(uint32(42)))
+		})
+
+		It("should repair a counter file written with mode 0", func() {
+			Expect(os.MkdirAll(filepath.Dir(rnf), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(rnf, []byte("7"), 0)).To(Succeed())
+			Expect(os.Chmod(rnf, 0)).To(Succeed())
+			rn, err := confsys.NewFileRunNumberProvider(rnf).NewRunNumber()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rn).To(Equal(uint32(8)))
+
+			fi, err := os.Stat(rnf)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fi.Mode().Perm()).To(Equal(os.FileMode(0644)))
+		})
+
+		It("should refuse a corrupted counter file", func() {
+			Expect(os.MkdirAll(filepath.Dir(rnf), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(rnf, []byte("not a number"), 0644)).To(Succeed())
+			_, err := confsys.NewFileRunNumberProvider(rnf).NewRunNumber()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should never hand out the same run number twice under concurrency", func() {
+			seen := allocateConcurrently(func() confsys.RunNumberProvider {
+				return confsys.NewFileRunNumberProvider(rnf)
+			}, 8, 25)
+			Expect(seen).To(HaveLen(200))
+			for rn, count := range seen {
+				Expect(count).To(Equal(1), "run number %d allocated %d times", rn, count)
+				Expect(rn).To(BeNumerically(">=", 1))
+				Expect(rn).To(BeNumerically("<=", 200))
+			}
+			leftovers, err := filepath.Glob(filepath.Join(filepath.Dir(rnf), ".runcounter.txt.tmp*"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(leftovers).To(BeEmpty())
+		})
+	})
+
+	Describe("run registry provider", func() {
+		var (
+			registry *runRegistryStandIn
+			server   *httptest.Server
+		)
+
+		BeforeEach(func() {
+			registry = &runRegistryStandIn{last: 100}
+			server = httptest.NewServer(registry)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should be selected by an http URI", func() {
+			rnp, err := confsys.NewRunNumberProvider(server.URL + "/runs")
+			Expect(err).NotTo(HaveOccurred())
+			rn, err := rnp.NewRunNumber()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rn).To(Equal(uint32(101)))
+		})
+
+		It("should return unique run numbers to concurrent callers", func() {
+			seen := allocateConcurrently(func() confsys.RunNumberProvider {
+				return confsys.NewHttpRunNumberProvider(server.URL)
+			}, 4, 10)
+			Expect(seen).To(HaveLen(40))
+		})
+
+		It("should report registry errors", func() {
+			registry.failing = true
+			_, err := confsys.NewHttpRunNumberProvider(server.URL).NewRunNumber()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("registry offline"))
+		})
+	})
+
+	Describe("local provider", func() {
+		It("should count up from the initial value", func() {
+			rnp, err := confsys.NewRunNumberProvider("local://")
+			Expect(err).NotTo(HaveOccurred())
+			seen := allocateConcurrently(func() confsys.RunNumberProvider { return rnp }, 4, 10)
+			Expect(seen).To(HaveLen(40))
+			rn, err := rnp.NewRunNumber()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rn).To(Equal(uint32(41)))
+		})
+	})
+
+	It("should reject unknown URIs", func() {
+		_, err := confsys.NewRunNumberProvider("ftp://somewhere")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -25,18 +25,19 @@
 package confsys
 
 import (
+	"errors"
+	"fmt"
 	"io/ioutil"
-	"os"
-	"path/filepath"
 	"strconv"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/AliceO2Group/Control/configuration"
-	"github.com/mitchellh/go-homedir"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
 )
 
 var log = logger.New(logrus.StandardLogger(), "confsys")
@@ -52,7 +53,9 @@ func Instance() *Service {
 		configUri := viper.GetString("globalConfigurationUri")
 		instance, err = newService(configUri)
 		if err != nil {
-			log.WithField("globalConfigurationUri", configUri).Fatal("bad configuration URI")
+			log.WithField("globalConfigurationUri", configUri).
+				WithError(err).
+				Fatal("cannot initialize configuration service")
 		}
 	})
 	return instance
@@ -62,6 +65,9 @@ func Instance() *Service {
 
 type Service struct {
 	src configuration.Source
+
+	mu  sync.Mutex
+	rnp RunNumberProvider
 }
 
 /* Expected structure:
@@ -70,6 +76,7 @@ type Service struct {
 	run_number_file: "/path/to/rn/file",
         - or -
 	run_number: 47102,
+	(unless the core is started with --runNumberProviderUri)
 
 	settings: {
 		log_level: "DEBUG"
@@ -97,7 +104,16 @@ func formatKey(key string) (consulKey string) {
 func newService(uri string) (svc *Service, err error) {
 	var src configuration.Source
 	src, err = configuration.NewSource(uri)
-	return &Service{src: src}, err
+	if err != nil {
+		return
+	}
+	svc = &Service{src: src}
+
+	rnpUri := viper.GetString("runNumberProviderUri")
+	if len(rnpUri) > 0 {
+		svc.rnp, err = NewRunNumberProvider(rnpUri)
+	}
+	return
 }
 
 func (s *Service) NewDefaultRepo(defaultRepo string) error {
@@ -124,67 +140,272 @@ func (s *Service) GetDefaultRepo() (defaultRepo string, err error) {
 }
 
 func (s *Service) NewRunNumber() (runNumber uint32, err error) {
+	var rnp RunNumberProvider
+	rnp, err = s.runNumberProvider()
+	if err != nil {
+		return
+	}
+	return rnp.NewRunNumber()
+}
+
+// SetRunNumberProvider overrides the run number provider otherwise chosen
+// from runNumberProviderUri or the configuration backend.
+func (s *Service) SetRunNumberProvider(rnp RunNumberProvider) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rnp = rnp
+}
+
+func (s *Service) runNumberProvider() (rnp RunNumberProvider, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.rnp != nil {
+		return s.rnp, nil
+	}
+
 	if cSrc, ok := s.src.(*configuration.ConsulSource); ok {
-		return cSrc.GetNextUInt32("o2/control/run_number")
-	} else {
-		// Unsafe check-and-set, only for file backend
-		var rnf string
-		rnf, err = s.src.Get("o2/control/run_number_file")
-		if err != nil {
-			return
-		}
-		rnf, err = homedir.Expand(rnf) // Resolve ~ into home dir
-		if err != nil {
-			return
-		}
-		if _, err = os.Stat(rnf); os.IsNotExist(err) {
-			err = os.MkdirAll(filepath.Dir(rnf), os.ModePerm)
-			if err != nil {
-				return
-			}
-			err = ioutil.WriteFile(rnf, []byte("0"), 0644)
-			if err != nil {
-				return
-			}
-		}
-		var raw []byte
-		raw, err = ioutil.ReadFile(rnf)
-		if err != nil {
-			return
-		}
-		var rn64 uint64
-		rn64, err = strconv.ParseUint(string(raw[:]), 10, 32)
-		if err != nil {
-			return
-		}
-		runNumber = uint32(rn64)
-		runNumber++
-		raw = []byte(strconv.FormatUint(uint64(runNumber), 10))
-		err = ioutil.WriteFile(rnf, raw, 0)
+		s.rnp = NewConsulRunNumberProvider(cSrc, "o2/control/run_number")
+		return s.rnp, nil
+	}
+
+	// The run number file may be changed in the configuration at any time,
+	// so we don't cache this one
+	var rnf string
+	rnf, err = s.src.Get("o2/control/run_number_file")
+	if err != nil {
 		return
 	}
+	return NewFileRunNumberProvider(rnf), nil
 }
 
 func (s *Service) GetROSource() configuration.ROSource {
 	return s.src
 }
 
+// bookkeepingKeys are written by the core itself during normal operation, e.g.
+// on every START, so they say nothing about how an environment is configured.
+var bookkeepingKeys = []string{
+	"o2/control/run_number",
+	"o2/control/runs/",
+	"o2/control/default_repo",
+}
+
+// IsBookkeepingKey returns true if key is one of the values written by the
+// core itself, such as the run number counter or a saved run configuration.
+func IsBookkeepingKey(key string) bool {
+	key = strings.TrimLeft(key, "/")
+	for _, bk := range bookkeepingKeys {
+		if strings.HasSuffix(bk, "/") {
+			if strings.HasPrefix(key, bk) {
+				return true
+			}
+		} else if key == bk || strings.HasPrefix(key, bk + "/") {
+			return true
+		}
+	}
+	return false
+}
+
+func runConfigurationKey(runNumber uint32) string {
+	return "o2/control/runs/" + strconv.FormatUint(uint64(runNumber), 10)
+}
+
+// StoreRunConfiguration saves the configuration snapshot of a run under its run number.
+func (s *Service) StoreRunConfiguration(runNumber uint32, runConfiguration configuration.Item) error {
+	return s.src.PutRecursive(runConfigurationKey(runNumber), runConfiguration)
+}
+
+// GetRunConfiguration returns the configuration snapshot saved for a run, if any.
+func (s *Service) GetRunConfiguration(runNumber uint32) (runConfiguration configuration.Item, err error) {
+	runConfiguration, err = s.src.GetRecursive(runConfigurationKey(runNumber))
+	if err != nil {
+		return
+	}
+	if runConfiguration == nil || runConfiguration.Type() != configuration.IT_Map || len(runConfiguration.Map()) == 0 {
+		err = fmt.Errorf("no configuration recorded for run %d", runNumber)
+	}
+	return
+}
+
+// Watch notifies about changes in the configuration store under prefix, if the
+// configuration backend supports it.
+func (s *Service) Watch(prefix string) (changes <-chan configuration.Change, cancel configuration.CancelFunc, err error) {
+	wSrc, ok := s.src.(configuration.WatchableSource)
+	if !ok {
+		err = errors.New("configuration source does not support watching for changes")
+		return
+	}
+	changes, cancel = wSrc.Watch(prefix)
+	return
+}
+
+// GetVars returns the global variables under o2/control/global_vars, or nil if
+// there are none.
 // maybe this one shouldn't exist at all, because vars should get inserted
 // response: but not all of them! some vars will likely only get parsed at deployment time i.e. right
 //    before pushing TaskInfos
 func (s *Service) GetVars() map[string]string {
-	//FIXME: implement
-	return nil
+	item, err := s.src.GetRecursive("o2/control/global_vars")
+	if err != nil || item == nil || item.Type() != configuration.IT_Map {
+		return nil
+	}
+	vars := make(map[string]string)
+	for k, v := range item.Map() {
+		if v.Type() != configuration.IT_Value {
+			log.WithField("key", "o2/control/global_vars/" + k).
+				Warning("global variable is not a plain value, skipping")
+			continue
+		}
+		vars[k] = v.Value()
+	}
+	return vars
 }
 
-// Or maybe even "RefreshConfig" which will refresh all the things that happen to be runtime-refreshable
-func (s *Service) RefreshRepositories() {
-	panic("not implemented yet")
+// RefreshRepositories re-reads the repository setup from the configuration
+// backend, i.e. the default repository and the list of repositories under
+// o2/control/repositories. Each entry of the latter may be either a plain
+// repository path or a map with a url field, and URLs are turned into
+// repository identifiers (host/user/repo) as used by the repos package.
+func (s *Service) RefreshRepositories() (defaultRepo string, repoList []string, err error) {
+	defaultRepo, err = s.GetDefaultRepo()
+	if err != nil {
+		err = errors.New(fmt.Sprintf("cannot read default repository: %s", err.Error()))
+		return
+	}
+	defaultRepo = strings.TrimSpace(defaultRepo)
+
+	if exists, _ := s.src.Exists("o2/control/repositories"); !exists {
+		return
+	}
+	var item configuration.Item
+	item, err = s.src.GetRecursive("o2/control/repositories")
+	if err != nil {
+		return
+	}
+
+	var entries []configuration.Item
+	switch item.Type() {
+	case configuration.IT_Map:
+		m := item.Map()
+		names := make([]string, 0, len(m))
+		for name := range m {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			entries = append(entries, m[name])
+		}
+	case configuration.IT_Array:
+		entries = item.Array()
+	default:
+		err = errors.New("o2/control/repositories must be a map or a list")
+		return
+	}
+
+	for _, entry := range entries {
+		var repoUrl string
+		switch entry.Type() {
+		case configuration.IT_Value:
+			repoUrl = entry.Value()
+		case configuration.IT_Map:
+			if urlItem, ok := entry.Map()["url"]; ok && urlItem.Type() == configuration.IT_Value {
+				repoUrl = urlItem.Value()
+			}
+		}
+		repoPath := repoPathFromUrl(repoUrl)
+		if len(repoPath) == 0 {
+			log.WithField("repository", entry).
+				Warning("cannot parse repository entry, skipping")
+			continue
+		}
+		repoList = append(repoList, repoPath)
+	}
+	return
 }
 
-// Returns a YAML file OR even a structure made of Roles or Nodes with:
-// import() functions already computed and resolved
-// vars inserted (todo: figure out which vars get parsed when and where)
-func (s *Service) GenerateWorkflowDescriptor(wfPath string, vars map[string]string /*vars from cli/gui*/) string {
-	panic("not implemented yet")
-}
\ No newline at end of file
+func repoPathFromUrl(repoUrl string) string {
+	repoPath := strings.TrimSpace(repoUrl)
+	if i := strings.Index(repoPath, "://"); i != -1 {
+		repoPath = repoPath[i+3:]
+	}
+	// user:pass@host/... is not a revision
+	if at, slash := strings.Index(repoPath, "@"), strings.Index(repoPath, "/"); at != -1 && at < slash {
+		repoPath = repoPath[at+1:]
+	}
+	revision := ""
+	if at := strings.LastIndex(repoPath, "@"); at != -1 {
+		revision = repoPath[at:]
+		repoPath = repoPath[:at]
+	}
+	repoPath = strings.TrimSuffix(strings.TrimSuffix(repoPath, "/"), ".git")
+	if len(repoPath) == 0 {
+		return ""
+	}
+	return repoPath + revision
+}
+
+// GenerateWorkflowDescriptor returns the workflow template found at wfPath (an
+// already resolved file path) as YAML, with its top level vars merged with
+// the global variables and with vars from cli/gui, the latter taking precedence
+// over the workflow's own defaults, which in turn override the global ones.
+func (s *Service) GenerateWorkflowDescriptor(wfPath string, vars map[string]string /*vars from cli/gui*/) (descriptor string, err error) {
+	var yamlDoc []byte
+	yamlDoc, err = ioutil.ReadFile(wfPath)
+	if err != nil {
+		return
+	}
+
+	var doc yaml.MapSlice
+	err = yaml.Unmarshal(yamlDoc, &doc)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("cannot parse workflow template %s: %s", wfPath, err.Error()))
+		return
+	}
+
+	merged := make(map[string]string)
+	for k, v := range s.GetVars() {
+		merged[k] = v
+	}
+	varsIndex := -1
+	for i, kv := range doc {
+		if key, ok := kv.Key.(string); ok && key == "vars" {
+			varsIndex = i
+			wfVars, ok := kv.Value.(yaml.MapSlice)
+			if !ok && kv.Value != nil {
+				err = errors.New(fmt.Sprintf("vars in workflow template %s must be a map", wfPath))
+				return
+			}
+			for _, wfVar := range wfVars {
+				merged[fmt.Sprintf("%v", wfVar.Key)] = fmt.Sprintf("%v", wfVar.Value)
+			}
+		}
+	}
+	for k, v := range vars {
+		merged[k] = v
+	}
+
+	if len(merged) > 0 {
+		keys := make([]string, 0, len(merged))
+		for k := range merged {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		mergedVars := make(yaml.MapSlice, 0, len(keys))
+		for _, k := range keys {
+			mergedVars = append(mergedVars, yaml.MapItem{Key: k, Value: merged[k]})
+		}
+		if varsIndex == -1 {
+			doc = append(doc, yaml.MapItem{Key: "vars", Value: mergedVars})
+		} else {
+			doc[varsIndex].Value = mergedVars
+		}
+	}
+
+	var out []byte
+	out, err = yaml.Marshal(doc)
+	if err != nil {
+		return
+	}
+	descriptor = string(out)
+	return
+}
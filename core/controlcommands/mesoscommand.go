@@ -27,13 +27,23 @@ package controlcommands
 import (
 	"github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/pborman/uuid"
+	"github.com/spf13/viper"
 	"time"
 )
 
 const (
-	defaultResponseTimeout = 45 * time.Second
+	fallbackResponseTimeout = 45 * time.Second
 )
 
+// defaultResponseTimeout is read anew for every command, so it can be changed
+// by a core configuration refresh.
+func defaultResponseTimeout() time.Duration {
+	if timeout := viper.GetDuration("mesosCommandTimeout"); timeout > 0 {
+		return timeout
+	}
+	return fallbackResponseTimeout
+}
+
 type MesosCommand interface {
 	GetName() string
 	GetId() uuid.Array
@@ -67,7 +77,7 @@ func NewMesosCommand(name string, receivers []MesosCommandTarget, argMap Propert
 	return &MesosCommandBase{
 		Name:            name,
 		Id:              uuid.NewUUID().Array(),
-		ResponseTimeout: defaultResponseTimeout,
+		ResponseTimeout: defaultResponseTimeout(),
 		TargetList:      receivers,
 		argMap:          argMap,
 	}
@@ -135,7 +145,7 @@ func (m *MesosCommandBase) GetResponseTimeout() time.Duration {
 	if m != nil {
 		return m.ResponseTimeout
 	}
-	return defaultResponseTimeout
+	return defaultResponseTimeout()
 }
 
 func (m *MesosCommandBase) targets() []MesosCommandTarget {
@@ -32,6 +32,7 @@ import (
 
 	"fmt"
 	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/AliceO2Group/Control/core/secrets"
 	"github.com/looplab/fsm"
 	"github.com/mesos/mesos-go/api/v1/lib/extras/scheduler/callrules"
 	"github.com/mesos/mesos-go/api/v1/lib/extras/store"
@@ -45,9 +46,9 @@ var log = logger.New(logrus.StandardLogger(),"core")
 // Run is the entry point for this scheduler.
 // TODO: refactor Config to reflect our specific requirements
 func Run() error {
-	if viper.GetBool("verbose") || viper.GetBool("veryVerbose") {
-		logrus.SetLevel(logrus.DebugLevel)
-	}
+	applyLogLevel()
+	// Resolved secrets must never end up in the logs
+	logrus.AddHook(secrets.RedactionHook{})
 
 	if viper.GetBool("veryVerbose") {
 		log.WithField("configuration", viper.AllSettings()).Debug("core starting up")
@@ -132,6 +133,9 @@ func Run() error {
 		},
 	)
 
+	// Keep track of configuration changes made while environments are running
+	state.environments.WatchConfiguration(ctx, "o2/control")
+
 	// We now build the Control server
 	s := NewServer(state, fidStore)
 
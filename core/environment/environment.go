@@ -49,18 +49,23 @@ type Environment struct {
 	id               uuid.UUID
 	ts               time.Time
 	workflow         workflow.Role
+	workflowPath     string
+	workflowRevision string
+	userVars         map[string]string
 	wfAdapter        *workflow.ParentAdapter
 	currentRunNumber uint32
+	configurationChanged bool
 }
 
-func newEnvironment() (env *Environment, err error) {
+func newEnvironment(userVars map[string]string) (env *Environment, err error) {
 	envId := uuid.NewUUID()
 	env = &Environment{
 		id: envId,
 		workflow: nil,
 		ts:  time.Now(),
+		userVars: userVars,
 	}
-    env.wfAdapter = workflow.NewParentAdapter(func() uuid.Array { return env.Id().Array() })
+    env.wfAdapter = workflow.NewParentAdapter(func() uuid.Array { return env.Id().Array() }, userVars)
 	env.Sm = fsm.NewFSM(
 		"STANDBY",
 		fsm.Events{
@@ -180,4 +185,21 @@ func (env *Environment) GetCurrentRunNumber() (rn uint32) {
 		return 0
 	}
 	return env.currentRunNumber
-}
\ No newline at end of file
+}
+
+// ConfigurationChanged returns true if the configuration store was modified after
+// this environment was last configured.
+func (env *Environment) ConfigurationChanged() bool {
+	if env == nil {
+		return false
+	}
+	env.Mu.RLock()
+	defer env.Mu.RUnlock()
+	return env.configurationChanged
+}
+
+func (env *Environment) setConfigurationChanged(changed bool) {
+	env.Mu.Lock()
+	defer env.Mu.Unlock()
+	env.configurationChanged = changed
+}
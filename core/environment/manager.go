@@ -25,17 +25,23 @@
 package environment
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/AliceO2Group/Control/configuration"
+	"github.com/AliceO2Group/Control/core/confsys"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/the"
 	"github.com/AliceO2Group/Control/core/workflow"
 	"github.com/pborman/uuid"
 )
 
+const configurationChangeSettleTime = 2 * time.Second
+
 type Manager struct {
 	mu      sync.RWMutex
 	m       map[uuid.Array]*Environment
@@ -49,15 +55,16 @@ func NewEnvManager(tm *task.Manager) *Manager {
 	}
 }
 
-func (envs *Manager) CreateEnvironment(workflowPath string) (uuid.UUID, error) {
+func (envs *Manager) CreateEnvironment(workflowPath string, userVars map[string]string) (uuid.UUID, error) {
 	envs.mu.Lock()
 	defer envs.mu.Unlock()
 
-	env, err := newEnvironment()
+	env, err := newEnvironment(userVars)
 	if err != nil {
 		return uuid.NIL, err
 	}
-	env.workflow, err = envs.loadWorkflow(workflowPath, env.wfAdapter)
+	env.workflowPath = workflowPath
+	env.workflow, env.workflowRevision, err = envs.loadWorkflow(workflowPath, env.wfAdapter, userVars)
 	if err != nil {
 		err = fmt.Errorf("cannot load workflow template: %s", err.Error())
 		return env.id, err
@@ -140,9 +147,83 @@ func (envs *Manager) environment(environmentId uuid.UUID) (env *Environment, err
 	return
 }
 
-func (envs *Manager) loadWorkflow(workflowPath string, parent workflow.Updatable) (root workflow.Role, err error) {
+func (envs *Manager) loadWorkflow(workflowPath string, parent workflow.Updatable, userVars map[string]string) (root workflow.Role, workflowRevision string, err error) {
 	if strings.Contains(workflowPath, "://") {
-		return nil, errors.New("workflow loading from file not implemented yet")
+		return nil, "", errors.New("workflow loading from file not implemented yet")
+	}
+	return workflow.Load(the.ConfSvc().GetROSource(), workflowPath, parent, envs.taskman, userVars)
+}
+
+// WatchConfiguration subscribes to changes in the configuration store under prefix.
+// Values written by the core itself (see confsys.IsBookkeepingKey) are ignored.
+// When something else changes, all environments which are already CONFIGURED or RUNNING
+// are flagged, and the known task classes are refreshed.
+// The watch stops when ctx is done.
+func (envs *Manager) WatchConfiguration(ctx context.Context, prefix string) {
+	changes, cancel, err := the.ConfSvc().Watch(prefix)
+	if err != nil {
+		log.WithError(err).
+			Warning("configuration changes will not be tracked")
+		return
+	}
+
+	go func() {
+		defer cancel()
+
+		var (
+			pending = make([]configuration.Change, 0)
+			settle <-chan time.Time
+		)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case change, ok := <-changes:
+				if !ok {
+					log.WithField("prefix", prefix).
+						Warning("configuration watch ended, changes will not be tracked anymore")
+					return
+				}
+				// The core itself writes run numbers and run configurations
+				// under the same prefix, those aren't configuration changes
+				if confsys.IsBookkeepingKey(change.Key) {
+					continue
+				}
+				// A single edit often touches many keys, so we wait for things to
+				// settle before acting on it
+				pending = append(pending, change)
+				settle = time.After(configurationChangeSettleTime)
+			case <-settle:
+				settle = nil
+				envs.handleConfigurationChanges(pending)
+				pending = make([]configuration.Change, 0)
+			}
+		}
+	}()
+}
+
+func (envs *Manager) handleConfigurationChanges(changes []configuration.Change) {
+	keys := make([]string, len(changes))
+	for i, change := range changes {
+		keys[i] = change.Key
+	}
+	log.WithField("keys", strings.Join(keys, ", ")).
+		Infof("configuration changed (%d values)", len(changes))
+
+	envs.mu.RLock()
+	for _, env := range envs.m {
+		state := env.CurrentState()
+		if state == "CONFIGURED" || state == "RUNNING" {
+			env.setConfigurationChanged(true)
+			log.WithField("environmentId", env.Id().String()).
+				Info("configuration changed since CONFIGURE")
+		}
+	}
+	envs.mu.RUnlock()
+
+	err := envs.taskman.RefreshKnownClasses()
+	if err != nil {
+		log.WithError(err).
+			Warning("cannot refresh task classes after configuration change")
 	}
-	return workflow.Load(the.ConfSvc().GetROSource(), workflowPath, parent, envs.taskman)
 }
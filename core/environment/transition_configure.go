@@ -31,6 +31,7 @@ import (
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/pborman/uuid"
 	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 )
 
 func NewConfigureTransition(taskman *task.Manager, addRoles []string, removeRoles []string, reconfigureAll bool) Transition {
@@ -147,7 +148,10 @@ func (t ConfigureTransition) do(env *Environment) (err error) {
 		return
 	}
 
-	deploymentTimeout := 90 * time.Second
+	deploymentTimeout := viper.GetDuration("deploymentTimeout")
+	if deploymentTimeout <= 0 {
+		deploymentTimeout = 90 * time.Second
+	}
 	wfStatus := wf.GetStatus()
 	if wfStatus != task.ACTIVE {
 		WORKFLOW_ACTIVE_LOOP:
@@ -179,6 +183,9 @@ func (t ConfigureTransition) do(env *Environment) (err error) {
 	if len(tasks) != 0 {
 		err = t.taskman.ConfigureTasks(env.Id().Array(), tasks)
 	}
+	if err == nil {
+		env.setConfigurationChanged(false)
+	}
 
 	return
 }
@@ -27,8 +27,10 @@ package environment
 import (
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/AliceO2Group/Control/common/logger/infologger"
+	"github.com/AliceO2Group/Control/configuration"
 	"github.com/AliceO2Group/Control/core/controlcommands"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/the"
@@ -79,5 +81,43 @@ func (t StartActivityTransition) do(env *Environment) (err error) {
 	}
 	log.WithField(infologger.Run, env.currentRunNumber).Info("run started")
 
+	// Failing to record the configuration must not prevent the run from going on
+	recordErr := recordRunConfiguration(env, t.taskman, runNumber)
+	if recordErr != nil {
+		log.WithField(infologger.Run, runNumber).
+			WithError(recordErr).
+			Warning("cannot record run configuration")
+	}
+
 	return
 }
+
+// recordRunConfiguration stores under the run number what each task of the environment
+// received with its last CONFIGURE transition, along with the workflow template revision.
+func recordRunConfiguration(env *Environment, taskman *task.Manager, runNumber uint32) error {
+	tasks := env.Workflow().GetTasks()
+	argsByTaskId := taskman.GetConfigureArgs(tasks)
+
+	tasksMap := make(configuration.Map)
+	for _, t := range tasks {
+		properties := make(configuration.Map)
+		for k, v := range argsByTaskId[t.GetTaskId()] {
+			properties[k] = configuration.String(v)
+		}
+		tasksMap[t.GetTaskId()] = configuration.Map{
+			"name":       configuration.String(t.GetName()),
+			"class":      configuration.String(t.GetClassName()),
+			"hostname":   configuration.String(t.GetHostname()),
+			"properties": properties,
+		}
+	}
+
+	runConfiguration := configuration.Map{
+		"environment_id":    configuration.String(env.Id().String()),
+		"workflow":          configuration.String(env.workflowPath),
+		"workflow_revision": configuration.String(env.workflowRevision),
+		"started":           configuration.String(time.Now().Format(time.RFC3339)),
+		"tasks":             tasksMap,
+	}
+	return the.ConfSvc().StoreRunConfiguration(runNumber, runConfiguration)
+}
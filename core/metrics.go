@@ -31,6 +31,7 @@ import (
 	"net"
 	"net/http"
 	"strconv"
+	"sync"
 
 	schedmetrics "github.com/AliceO2Group/Control/core/metrics"
 	xmetrics "github.com/mesos/mesos-go/api/v1/lib/extras/metrics"
@@ -40,15 +41,51 @@ import (
 	"github.com/spf13/viper"
 )
 
+var (
+	metricsServerMu sync.Mutex
+	metricsServer   *http.Server
+)
+
 func initMetrics() *metricsAPI {
 	schedmetrics.Register()
-	metricsAddress := net.JoinHostPort(viper.GetString("metrics.address"), strconv.Itoa(viper.GetInt("metrics.port")))
-	http.Handle(viper.GetString("metrics.path"), promhttp.Handler())
 	api := newMetricsAPI()
-	go forever("api-server", viper.GetDuration("mesosJobRestartDelay"), api.jobStartCount, func() error { return http.ListenAndServe(metricsAddress, nil) })
+	go forever("api-server", viper.GetDuration("mesosJobRestartDelay"), api.jobStartCount, serveMetrics)
 	return api
 }
 
+// serveMetrics serves the metrics endpoint according to the current metrics.*
+// settings, until the server fails or is closed by restartMetricsServer.
+func serveMetrics() error {
+	mux := http.NewServeMux()
+	mux.Handle(viper.GetString("metrics.path"), promhttp.Handler())
+	server := &http.Server{
+		Addr:    net.JoinHostPort(viper.GetString("metrics.address"), strconv.Itoa(viper.GetInt("metrics.port"))),
+		Handler: mux,
+	}
+
+	metricsServerMu.Lock()
+	metricsServer = server
+	metricsServerMu.Unlock()
+
+	return server.ListenAndServe()
+}
+
+// restartMetricsServer closes the metrics server, which forever then starts
+// again with the metrics.* settings in effect at that time.
+func restartMetricsServer() {
+	metricsServerMu.Lock()
+	defer metricsServerMu.Unlock()
+
+	if metricsServer == nil {
+		return
+	}
+	err := metricsServer.Close()
+	if err != nil {
+		log.WithError(err).Warning("cannot close metrics server")
+	}
+	metricsServer = nil
+}
+
 func newMetricAdder(m prometheus.Counter) xmetrics.Adder {
 	return func(x float64, _ ...string) { m.Add(x) }
 }
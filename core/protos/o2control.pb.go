@@ -91,7 +91,7 @@ func (x ControlEnvironmentRequest_Optype) String() string {
 }
 
 func (ControlEnvironmentRequest_Optype) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{16, 0}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{23, 0}
 }
 
 type EnvironmentOperation_Optype int32
@@ -119,7 +119,7 @@ func (x EnvironmentOperation_Optype) String() string {
 }
 
 func (EnvironmentOperation_Optype) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{19, 0}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{26, 0}
 }
 
 type Event_MesosHeartbeat struct {
@@ -652,6 +652,163 @@ func (m *TeardownReply) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TeardownReply proto.InternalMessageInfo
 
+type RefreshConfigRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshConfigRequest) Reset()         { *m = RefreshConfigRequest{} }
+func (m *RefreshConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshConfigRequest) ProtoMessage()    {}
+func (*RefreshConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{9}
+}
+func (m *RefreshConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshConfigRequest.Merge(m, src)
+}
+func (m *RefreshConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshConfigRequest proto.InternalMessageInfo
+
+type SettingChange struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	OldValue             string   `protobuf:"bytes,2,opt,name=oldValue,proto3" json:"oldValue,omitempty"`
+	NewValue             string   `protobuf:"bytes,3,opt,name=newValue,proto3" json:"newValue,omitempty"`
+	RestartRequired      bool     `protobuf:"varint,4,opt,name=restartRequired,proto3" json:"restartRequired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SettingChange) Reset()         { *m = SettingChange{} }
+func (m *SettingChange) String() string { return proto.CompactTextString(m) }
+func (*SettingChange) ProtoMessage()    {}
+func (*SettingChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{10}
+}
+func (m *SettingChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SettingChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SettingChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SettingChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettingChange.Merge(m, src)
+}
+func (m *SettingChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SettingChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettingChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettingChange proto.InternalMessageInfo
+
+func (m *SettingChange) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SettingChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
+}
+
+func (m *SettingChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
+	}
+	return ""
+}
+
+func (m *SettingChange) GetRestartRequired() bool {
+	if m != nil {
+		return m.RestartRequired
+	}
+	return false
+}
+
+type RefreshConfigReply struct {
+	Changes              []*SettingChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RefreshConfigReply) Reset()         { *m = RefreshConfigReply{} }
+func (m *RefreshConfigReply) String() string { return proto.CompactTextString(m) }
+func (*RefreshConfigReply) ProtoMessage()    {}
+func (*RefreshConfigReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{11}
+}
+func (m *RefreshConfigReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefreshConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefreshConfigReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RefreshConfigReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshConfigReply.Merge(m, src)
+}
+func (m *RefreshConfigReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefreshConfigReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshConfigReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshConfigReply proto.InternalMessageInfo
+
+func (m *RefreshConfigReply) GetChanges() []*SettingChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
 ////////////////////////////////////////
 // Environment
 ////////////////////////////////////////
@@ -665,7 +822,7 @@ func (m *GetEnvironmentsRequest) Reset()         { *m = GetEnvironmentsRequest{}
 func (m *GetEnvironmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetEnvironmentsRequest) ProtoMessage()    {}
 func (*GetEnvironmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{9}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{12}
 }
 func (m *GetEnvironmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -706,7 +863,7 @@ func (m *GetEnvironmentsReply) Reset()         { *m = GetEnvironmentsReply{} }
 func (m *GetEnvironmentsReply) String() string { return proto.CompactTextString(m) }
 func (*GetEnvironmentsReply) ProtoMessage()    {}
 func (*GetEnvironmentsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{10}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{13}
 }
 func (m *GetEnvironmentsReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -756,6 +913,7 @@ type EnvironmentInfo struct {
 	Tasks                []*ShortTaskInfo `protobuf:"bytes,4,rep,name=tasks,proto3" json:"tasks,omitempty"`
 	RootRole             string           `protobuf:"bytes,5,opt,name=rootRole,proto3" json:"rootRole,omitempty"`
 	CurrentRunNumber     uint32           `protobuf:"varint,6,opt,name=currentRunNumber,proto3" json:"currentRunNumber,omitempty"`
+	ConfigurationChanged bool             `protobuf:"varint,7,opt,name=configurationChanged,proto3" json:"configurationChanged,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -765,7 +923,7 @@ func (m *EnvironmentInfo) Reset()         { *m = EnvironmentInfo{} }
 func (m *EnvironmentInfo) String() string { return proto.CompactTextString(m) }
 func (*EnvironmentInfo) ProtoMessage()    {}
 func (*EnvironmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{11}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{14}
 }
 func (m *EnvironmentInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,18 +994,26 @@ func (m *EnvironmentInfo) GetCurrentRunNumber() uint32 {
 	return 0
 }
 
+func (m *EnvironmentInfo) GetConfigurationChanged() bool {
+	if m != nil {
+		return m.ConfigurationChanged
+	}
+	return false
+}
+
 type NewEnvironmentRequest struct {
-	WorkflowTemplate     string   `protobuf:"bytes,1,opt,name=workflowTemplate,proto3" json:"workflowTemplate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	WorkflowTemplate     string            `protobuf:"bytes,1,opt,name=workflowTemplate,proto3" json:"workflowTemplate,omitempty"`
+	Vars                 map[string]string `protobuf:"bytes,2,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *NewEnvironmentRequest) Reset()         { *m = NewEnvironmentRequest{} }
 func (m *NewEnvironmentRequest) String() string { return proto.CompactTextString(m) }
 func (*NewEnvironmentRequest) ProtoMessage()    {}
 func (*NewEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{12}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{15}
 }
 func (m *NewEnvironmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,6 +1049,13 @@ func (m *NewEnvironmentRequest) GetWorkflowTemplate() string {
 	return ""
 }
 
+func (m *NewEnvironmentRequest) GetVars() map[string]string {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
 type NewEnvironmentReply struct {
 	Environment          *EnvironmentInfo `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
@@ -894,7 +1067,7 @@ func (m *NewEnvironmentReply) Reset()         { *m = NewEnvironmentReply{} }
 func (m *NewEnvironmentReply) String() string { return proto.CompactTextString(m) }
 func (*NewEnvironmentReply) ProtoMessage()    {}
 func (*NewEnvironmentReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{13}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{16}
 }
 func (m *NewEnvironmentReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1114,7 @@ func (m *GetEnvironmentRequest) Reset()         { *m = GetEnvironmentRequest{} }
 func (m *GetEnvironmentRequest) String() string { return proto.CompactTextString(m) }
 func (*GetEnvironmentRequest) ProtoMessage()    {}
 func (*GetEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{14}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{17}
 }
 func (m *GetEnvironmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -989,7 +1162,7 @@ func (m *GetEnvironmentReply) Reset()         { *m = GetEnvironmentReply{} }
 func (m *GetEnvironmentReply) String() string { return proto.CompactTextString(m) }
 func (*GetEnvironmentReply) ProtoMessage()    {}
 func (*GetEnvironmentReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{15}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{18}
 }
 func (m *GetEnvironmentReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1032,26 +1205,25 @@ func (m *GetEnvironmentReply) GetWorkflow() *RoleInfo {
 	return nil
 }
 
-type ControlEnvironmentRequest struct {
-	Id                   string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type                 ControlEnvironmentRequest_Optype `protobuf:"varint,2,opt,name=type,proto3,enum=o2control.ControlEnvironmentRequest_Optype" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+type GetEnvironmentTopologyRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ControlEnvironmentRequest) Reset()         { *m = ControlEnvironmentRequest{} }
-func (m *ControlEnvironmentRequest) String() string { return proto.CompactTextString(m) }
-func (*ControlEnvironmentRequest) ProtoMessage()    {}
-func (*ControlEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{16}
+func (m *GetEnvironmentTopologyRequest) Reset()         { *m = GetEnvironmentTopologyRequest{} }
+func (m *GetEnvironmentTopologyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetEnvironmentTopologyRequest) ProtoMessage()    {}
+func (*GetEnvironmentTopologyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{19}
 }
-func (m *ControlEnvironmentRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetEnvironmentTopologyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ControlEnvironmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetEnvironmentTopologyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ControlEnvironmentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetEnvironmentTopologyRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1061,53 +1233,49 @@ func (m *ControlEnvironmentRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ControlEnvironmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ControlEnvironmentRequest.Merge(m, src)
+func (m *GetEnvironmentTopologyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEnvironmentTopologyRequest.Merge(m, src)
 }
-func (m *ControlEnvironmentRequest) XXX_Size() int {
+func (m *GetEnvironmentTopologyRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ControlEnvironmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ControlEnvironmentRequest.DiscardUnknown(m)
+func (m *GetEnvironmentTopologyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEnvironmentTopologyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ControlEnvironmentRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetEnvironmentTopologyRequest proto.InternalMessageInfo
 
-func (m *ControlEnvironmentRequest) GetId() string {
+func (m *GetEnvironmentTopologyRequest) GetId() string {
 	if m != nil {
 		return m.Id
 	}
 	return ""
 }
 
-func (m *ControlEnvironmentRequest) GetType() ControlEnvironmentRequest_Optype {
-	if m != nil {
-		return m.Type
-	}
-	return ControlEnvironmentRequest_NOOP
-}
-
-type ControlEnvironmentReply struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
-	CurrentRunNumber     uint32   `protobuf:"varint,3,opt,name=currentRunNumber,proto3" json:"currentRunNumber,omitempty"`
+type TopologyNode struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	RolePath             string   `protobuf:"bytes,3,opt,name=rolePath,proto3" json:"rolePath,omitempty"`
+	Hostname             string   `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	ClassName            string   `protobuf:"bytes,5,opt,name=className,proto3" json:"className,omitempty"`
+	State                string   `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ControlEnvironmentReply) Reset()         { *m = ControlEnvironmentReply{} }
-func (m *ControlEnvironmentReply) String() string { return proto.CompactTextString(m) }
-func (*ControlEnvironmentReply) ProtoMessage()    {}
-func (*ControlEnvironmentReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{17}
+func (m *TopologyNode) Reset()         { *m = TopologyNode{} }
+func (m *TopologyNode) String() string { return proto.CompactTextString(m) }
+func (*TopologyNode) ProtoMessage()    {}
+func (*TopologyNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{20}
 }
-func (m *ControlEnvironmentReply) XXX_Unmarshal(b []byte) error {
+func (m *TopologyNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ControlEnvironmentReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopologyNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ControlEnvironmentReply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopologyNode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1117,60 +1285,87 @@ func (m *ControlEnvironmentReply) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ControlEnvironmentReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ControlEnvironmentReply.Merge(m, src)
+func (m *TopologyNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopologyNode.Merge(m, src)
 }
-func (m *ControlEnvironmentReply) XXX_Size() int {
+func (m *TopologyNode) XXX_Size() int {
 	return m.Size()
 }
-func (m *ControlEnvironmentReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ControlEnvironmentReply.DiscardUnknown(m)
+func (m *TopologyNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopologyNode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ControlEnvironmentReply proto.InternalMessageInfo
+var xxx_messageInfo_TopologyNode proto.InternalMessageInfo
 
-func (m *ControlEnvironmentReply) GetId() string {
+func (m *TopologyNode) GetTaskId() string {
 	if m != nil {
-		return m.Id
+		return m.TaskId
 	}
 	return ""
 }
 
-func (m *ControlEnvironmentReply) GetState() string {
+func (m *TopologyNode) GetName() string {
 	if m != nil {
-		return m.State
+		return m.Name
 	}
 	return ""
 }
 
-func (m *ControlEnvironmentReply) GetCurrentRunNumber() uint32 {
+func (m *TopologyNode) GetRolePath() string {
 	if m != nil {
-		return m.CurrentRunNumber
+		return m.RolePath
 	}
-	return 0
+	return ""
 }
 
-type ModifyEnvironmentRequest struct {
-	Id                   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Operations           []*EnvironmentOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
-	ReconfigureAll       bool                    `protobuf:"varint,3,opt,name=reconfigureAll,proto3" json:"reconfigureAll,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+func (m *TopologyNode) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
 }
 
-func (m *ModifyEnvironmentRequest) Reset()         { *m = ModifyEnvironmentRequest{} }
-func (m *ModifyEnvironmentRequest) String() string { return proto.CompactTextString(m) }
-func (*ModifyEnvironmentRequest) ProtoMessage()    {}
-func (*ModifyEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{18}
+func (m *TopologyNode) GetClassName() string {
+	if m != nil {
+		return m.ClassName
+	}
+	return ""
 }
-func (m *ModifyEnvironmentRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *TopologyNode) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+// An edge goes from the task with an outbound channel to the task which binds
+// its target. targetTaskId is empty if the target is an explicit address or if
+// it could not be resolved, in the latter case the endpoint is empty too.
+type TopologyEdge struct {
+	SourceTaskId         string   `protobuf:"bytes,1,opt,name=sourceTaskId,proto3" json:"sourceTaskId,omitempty"`
+	TargetTaskId         string   `protobuf:"bytes,2,opt,name=targetTaskId,proto3" json:"targetTaskId,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Target               string   `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty"`
+	Endpoint             string   `protobuf:"bytes,6,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TopologyEdge) Reset()         { *m = TopologyEdge{} }
+func (m *TopologyEdge) String() string { return proto.CompactTextString(m) }
+func (*TopologyEdge) ProtoMessage()    {}
+func (*TopologyEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{21}
+}
+func (m *TopologyEdge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ModifyEnvironmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TopologyEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ModifyEnvironmentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TopologyEdge.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1180,52 +1375,317 @@ func (m *ModifyEnvironmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ModifyEnvironmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ModifyEnvironmentRequest.Merge(m, src)
+func (m *TopologyEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TopologyEdge.Merge(m, src)
 }
-func (m *ModifyEnvironmentRequest) XXX_Size() int {
+func (m *TopologyEdge) XXX_Size() int {
 	return m.Size()
 }
-func (m *ModifyEnvironmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ModifyEnvironmentRequest.DiscardUnknown(m)
+func (m *TopologyEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_TopologyEdge.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ModifyEnvironmentRequest proto.InternalMessageInfo
+var xxx_messageInfo_TopologyEdge proto.InternalMessageInfo
 
-func (m *ModifyEnvironmentRequest) GetId() string {
+func (m *TopologyEdge) GetSourceTaskId() string {
 	if m != nil {
-		return m.Id
+		return m.SourceTaskId
 	}
 	return ""
 }
 
-func (m *ModifyEnvironmentRequest) GetOperations() []*EnvironmentOperation {
+func (m *TopologyEdge) GetTargetTaskId() string {
 	if m != nil {
-		return m.Operations
+		return m.TargetTaskId
 	}
-	return nil
+	return ""
 }
 
-func (m *ModifyEnvironmentRequest) GetReconfigureAll() bool {
+func (m *TopologyEdge) GetName() string {
 	if m != nil {
-		return m.ReconfigureAll
+		return m.Name
 	}
-	return false
+	return ""
 }
 
-type EnvironmentOperation struct {
-	Type                 EnvironmentOperation_Optype `protobuf:"varint,1,opt,name=type,proto3,enum=o2control.EnvironmentOperation_Optype" json:"type,omitempty"`
-	RoleName             string                      `protobuf:"bytes,2,opt,name=roleName,proto3" json:"roleName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+func (m *TopologyEdge) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *EnvironmentOperation) Reset()         { *m = EnvironmentOperation{} }
-func (m *EnvironmentOperation) String() string { return proto.CompactTextString(m) }
+func (m *TopologyEdge) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *TopologyEdge) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+type GetEnvironmentTopologyReply struct {
+	EnvironmentId        string          `protobuf:"bytes,1,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	Nodes                []*TopologyNode `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges                []*TopologyEdge `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetEnvironmentTopologyReply) Reset()         { *m = GetEnvironmentTopologyReply{} }
+func (m *GetEnvironmentTopologyReply) String() string { return proto.CompactTextString(m) }
+func (*GetEnvironmentTopologyReply) ProtoMessage()    {}
+func (*GetEnvironmentTopologyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{22}
+}
+func (m *GetEnvironmentTopologyReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetEnvironmentTopologyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetEnvironmentTopologyReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetEnvironmentTopologyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEnvironmentTopologyReply.Merge(m, src)
+}
+func (m *GetEnvironmentTopologyReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetEnvironmentTopologyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEnvironmentTopologyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetEnvironmentTopologyReply proto.InternalMessageInfo
+
+func (m *GetEnvironmentTopologyReply) GetEnvironmentId() string {
+	if m != nil {
+		return m.EnvironmentId
+	}
+	return ""
+}
+
+func (m *GetEnvironmentTopologyReply) GetNodes() []*TopologyNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *GetEnvironmentTopologyReply) GetEdges() []*TopologyEdge {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
+}
+
+type ControlEnvironmentRequest struct {
+	Id                   string                           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 ControlEnvironmentRequest_Optype `protobuf:"varint,2,opt,name=type,proto3,enum=o2control.ControlEnvironmentRequest_Optype" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ControlEnvironmentRequest) Reset()         { *m = ControlEnvironmentRequest{} }
+func (m *ControlEnvironmentRequest) String() string { return proto.CompactTextString(m) }
+func (*ControlEnvironmentRequest) ProtoMessage()    {}
+func (*ControlEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{23}
+}
+func (m *ControlEnvironmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControlEnvironmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControlEnvironmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ControlEnvironmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlEnvironmentRequest.Merge(m, src)
+}
+func (m *ControlEnvironmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControlEnvironmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlEnvironmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlEnvironmentRequest proto.InternalMessageInfo
+
+func (m *ControlEnvironmentRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ControlEnvironmentRequest) GetType() ControlEnvironmentRequest_Optype {
+	if m != nil {
+		return m.Type
+	}
+	return ControlEnvironmentRequest_NOOP
+}
+
+type ControlEnvironmentReply struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	State                string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	CurrentRunNumber     uint32   `protobuf:"varint,3,opt,name=currentRunNumber,proto3" json:"currentRunNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ControlEnvironmentReply) Reset()         { *m = ControlEnvironmentReply{} }
+func (m *ControlEnvironmentReply) String() string { return proto.CompactTextString(m) }
+func (*ControlEnvironmentReply) ProtoMessage()    {}
+func (*ControlEnvironmentReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{24}
+}
+func (m *ControlEnvironmentReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControlEnvironmentReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControlEnvironmentReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ControlEnvironmentReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControlEnvironmentReply.Merge(m, src)
+}
+func (m *ControlEnvironmentReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControlEnvironmentReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControlEnvironmentReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControlEnvironmentReply proto.InternalMessageInfo
+
+func (m *ControlEnvironmentReply) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ControlEnvironmentReply) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ControlEnvironmentReply) GetCurrentRunNumber() uint32 {
+	if m != nil {
+		return m.CurrentRunNumber
+	}
+	return 0
+}
+
+type ModifyEnvironmentRequest struct {
+	Id                   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operations           []*EnvironmentOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	ReconfigureAll       bool                    `protobuf:"varint,3,opt,name=reconfigureAll,proto3" json:"reconfigureAll,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *ModifyEnvironmentRequest) Reset()         { *m = ModifyEnvironmentRequest{} }
+func (m *ModifyEnvironmentRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyEnvironmentRequest) ProtoMessage()    {}
+func (*ModifyEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{25}
+}
+func (m *ModifyEnvironmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModifyEnvironmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModifyEnvironmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModifyEnvironmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyEnvironmentRequest.Merge(m, src)
+}
+func (m *ModifyEnvironmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModifyEnvironmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyEnvironmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyEnvironmentRequest proto.InternalMessageInfo
+
+func (m *ModifyEnvironmentRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ModifyEnvironmentRequest) GetOperations() []*EnvironmentOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+func (m *ModifyEnvironmentRequest) GetReconfigureAll() bool {
+	if m != nil {
+		return m.ReconfigureAll
+	}
+	return false
+}
+
+type EnvironmentOperation struct {
+	Type                 EnvironmentOperation_Optype `protobuf:"varint,1,opt,name=type,proto3,enum=o2control.EnvironmentOperation_Optype" json:"type,omitempty"`
+	RoleName             string                      `protobuf:"bytes,2,opt,name=roleName,proto3" json:"roleName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *EnvironmentOperation) Reset()         { *m = EnvironmentOperation{} }
+func (m *EnvironmentOperation) String() string { return proto.CompactTextString(m) }
 func (*EnvironmentOperation) ProtoMessage()    {}
 func (*EnvironmentOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{19}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{26}
 }
 func (m *EnvironmentOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1281,7 +1741,7 @@ func (m *ModifyEnvironmentReply) Reset()         { *m = ModifyEnvironmentReply{}
 func (m *ModifyEnvironmentReply) String() string { return proto.CompactTextString(m) }
 func (*ModifyEnvironmentReply) ProtoMessage()    {}
 func (*ModifyEnvironmentReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{20}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{27}
 }
 func (m *ModifyEnvironmentReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1343,7 +1803,7 @@ func (m *DestroyEnvironmentRequest) Reset()         { *m = DestroyEnvironmentReq
 func (m *DestroyEnvironmentRequest) String() string { return proto.CompactTextString(m) }
 func (*DestroyEnvironmentRequest) ProtoMessage()    {}
 func (*DestroyEnvironmentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{21}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{28}
 }
 func (m *DestroyEnvironmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1397,7 +1857,7 @@ func (m *DestroyEnvironmentReply) Reset()         { *m = DestroyEnvironmentReply
 func (m *DestroyEnvironmentReply) String() string { return proto.CompactTextString(m) }
 func (*DestroyEnvironmentReply) ProtoMessage()    {}
 func (*DestroyEnvironmentReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{22}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{29}
 }
 func (m *DestroyEnvironmentReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,7 +1913,7 @@ func (m *ShortTaskInfo) Reset()         { *m = ShortTaskInfo{} }
 func (m *ShortTaskInfo) String() string { return proto.CompactTextString(m) }
 func (*ShortTaskInfo) ProtoMessage()    {}
 func (*ShortTaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{23}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{30}
 }
 func (m *ShortTaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +2005,7 @@ func (m *TaskDeploymentInfo) Reset()         { *m = TaskDeploymentInfo{} }
 func (m *TaskDeploymentInfo) String() string { return proto.CompactTextString(m) }
 func (*TaskDeploymentInfo) ProtoMessage()    {}
 func (*TaskDeploymentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{24}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{31}
 }
 func (m *TaskDeploymentInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +2072,7 @@ func (m *GetTasksRequest) Reset()         { *m = GetTasksRequest{} }
 func (m *GetTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTasksRequest) ProtoMessage()    {}
 func (*GetTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{25}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{32}
 }
 func (m *GetTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1652,7 +2112,7 @@ func (m *GetTasksReply) Reset()         { *m = GetTasksReply{} }
 func (m *GetTasksReply) String() string { return proto.CompactTextString(m) }
 func (*GetTasksReply) ProtoMessage()    {}
 func (*GetTasksReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{26}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{33}
 }
 func (m *GetTasksReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1699,7 +2159,7 @@ func (m *GetTaskRequest) Reset()         { *m = GetTaskRequest{} }
 func (m *GetTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTaskRequest) ProtoMessage()    {}
 func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{27}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{34}
 }
 func (m *GetTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1746,7 +2206,7 @@ func (m *GetTaskReply) Reset()         { *m = GetTaskReply{} }
 func (m *GetTaskReply) String() string { return proto.CompactTextString(m) }
 func (*GetTaskReply) ProtoMessage()    {}
 func (*GetTaskReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{28}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{35}
 }
 func (m *GetTaskReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1794,7 +2254,7 @@ func (m *TaskClassInfo) Reset()         { *m = TaskClassInfo{} }
 func (m *TaskClassInfo) String() string { return proto.CompactTextString(m) }
 func (*TaskClassInfo) ProtoMessage()    {}
 func (*TaskClassInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{29}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{36}
 }
 func (m *TaskClassInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1852,7 +2312,7 @@ func (m *CommandInfo) Reset()         { *m = CommandInfo{} }
 func (m *CommandInfo) String() string { return proto.CompactTextString(m) }
 func (*CommandInfo) ProtoMessage()    {}
 func (*CommandInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{30}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{37}
 }
 func (m *CommandInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1929,7 +2389,7 @@ func (m *ChannelInfo) Reset()         { *m = ChannelInfo{} }
 func (m *ChannelInfo) String() string { return proto.CompactTextString(m) }
 func (*ChannelInfo) ProtoMessage()    {}
 func (*ChannelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{31}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{38}
 }
 func (m *ChannelInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1996,7 +2456,7 @@ func (m *TaskInfo) Reset()         { *m = TaskInfo{} }
 func (m *TaskInfo) String() string { return proto.CompactTextString(m) }
 func (*TaskInfo) ProtoMessage()    {}
 func (*TaskInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{32}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{39}
 }
 func (m *TaskInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2085,7 +2545,7 @@ func (m *CleanupTasksRequest) Reset()         { *m = CleanupTasksRequest{} }
 func (m *CleanupTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*CleanupTasksRequest) ProtoMessage()    {}
 func (*CleanupTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{33}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{40}
 }
 func (m *CleanupTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2133,7 +2593,7 @@ func (m *CleanupTasksReply) Reset()         { *m = CleanupTasksReply{} }
 func (m *CleanupTasksReply) String() string { return proto.CompactTextString(m) }
 func (*CleanupTasksReply) ProtoMessage()    {}
 func (*CleanupTasksReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{34}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{41}
 }
 func (m *CleanupTasksReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2191,7 +2651,7 @@ func (m *GetRolesRequest) Reset()         { *m = GetRolesRequest{} }
 func (m *GetRolesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRolesRequest) ProtoMessage()    {}
 func (*GetRolesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{35}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{42}
 }
 func (m *GetRolesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2241,6 +2701,9 @@ type RoleInfo struct {
 	FullPath             string      `protobuf:"bytes,4,opt,name=fullPath,proto3" json:"fullPath,omitempty"`
 	TaskIds              []string    `protobuf:"bytes,5,rep,name=taskIds,proto3" json:"taskIds,omitempty"`
 	Roles                []*RoleInfo `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	Pruned               bool        `protobuf:"varint,7,opt,name=pruned,proto3" json:"pruned,omitempty"`
+	PruneReason          string      `protobuf:"bytes,8,opt,name=pruneReason,proto3" json:"pruneReason,omitempty"`
+	Vars                 []*VarInfo  `protobuf:"bytes,9,rep,name=vars,proto3" json:"vars,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -2250,7 +2713,7 @@ func (m *RoleInfo) Reset()         { *m = RoleInfo{} }
 func (m *RoleInfo) String() string { return proto.CompactTextString(m) }
 func (*RoleInfo) ProtoMessage()    {}
 func (*RoleInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{36}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{43}
 }
 func (m *RoleInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2321,6 +2784,92 @@ func (m *RoleInfo) GetRoles() []*RoleInfo {
 	return nil
 }
 
+func (m *RoleInfo) GetPruned() bool {
+	if m != nil {
+		return m.Pruned
+	}
+	return false
+}
+
+func (m *RoleInfo) GetPruneReason() string {
+	if m != nil {
+		return m.PruneReason
+	}
+	return ""
+}
+
+func (m *RoleInfo) GetVars() []*VarInfo {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
+// The effective value of a role variable, source is the layer which supplied
+// it: task class, configuration, role <path> or environment.
+type VarInfo struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Source               string   `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VarInfo) Reset()         { *m = VarInfo{} }
+func (m *VarInfo) String() string { return proto.CompactTextString(m) }
+func (*VarInfo) ProtoMessage()    {}
+func (*VarInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{44}
+}
+func (m *VarInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VarInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VarInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VarInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VarInfo.Merge(m, src)
+}
+func (m *VarInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *VarInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VarInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VarInfo proto.InternalMessageInfo
+
+func (m *VarInfo) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *VarInfo) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *VarInfo) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
 type GetRolesReply struct {
 	Roles                []*RoleInfo `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -2332,7 +2881,7 @@ func (m *GetRolesReply) Reset()         { *m = GetRolesReply{} }
 func (m *GetRolesReply) String() string { return proto.CompactTextString(m) }
 func (*GetRolesReply) ProtoMessage()    {}
 func (*GetRolesReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{37}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{45}
 }
 func (m *GetRolesReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2378,7 +2927,7 @@ func (m *GetWorkflowTemplatesRequest) Reset()         { *m = GetWorkflowTemplate
 func (m *GetWorkflowTemplatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowTemplatesRequest) ProtoMessage()    {}
 func (*GetWorkflowTemplatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{38}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{46}
 }
 func (m *GetWorkflowTemplatesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2968,7 @@ func (m *WorkflowTemplateInfo) Reset()         { *m = WorkflowTemplateInfo{} }
 func (m *WorkflowTemplateInfo) String() string { return proto.CompactTextString(m) }
 func (*WorkflowTemplateInfo) ProtoMessage()    {}
 func (*WorkflowTemplateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{39}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{47}
 }
 func (m *WorkflowTemplateInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2473,7 +3022,7 @@ func (m *GetWorkflowTemplatesReply) Reset()         { *m = GetWorkflowTemplatesR
 func (m *GetWorkflowTemplatesReply) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowTemplatesReply) ProtoMessage()    {}
 func (*GetWorkflowTemplatesReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{40}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{48}
 }
 func (m *GetWorkflowTemplatesReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2509,24 +3058,26 @@ func (m *GetWorkflowTemplatesReply) GetWorkflowTemplates() []*WorkflowTemplateIn
 	return nil
 }
 
-type ListReposRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RenderWorkflowTemplateRequest struct {
+	WorkflowTemplate     string            `protobuf:"bytes,1,opt,name=workflowTemplate,proto3" json:"workflowTemplate,omitempty"`
+	Vars                 map[string]string `protobuf:"bytes,2,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *ListReposRequest) Reset()         { *m = ListReposRequest{} }
-func (m *ListReposRequest) String() string { return proto.CompactTextString(m) }
-func (*ListReposRequest) ProtoMessage()    {}
-func (*ListReposRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{41}
+func (m *RenderWorkflowTemplateRequest) Reset()         { *m = RenderWorkflowTemplateRequest{} }
+func (m *RenderWorkflowTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*RenderWorkflowTemplateRequest) ProtoMessage()    {}
+func (*RenderWorkflowTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{49}
 }
-func (m *ListReposRequest) XXX_Unmarshal(b []byte) error {
+func (m *RenderWorkflowTemplateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListReposRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenderWorkflowTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListReposRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenderWorkflowTemplateRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2536,38 +3087,53 @@ func (m *ListReposRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *ListReposRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListReposRequest.Merge(m, src)
+func (m *RenderWorkflowTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderWorkflowTemplateRequest.Merge(m, src)
 }
-func (m *ListReposRequest) XXX_Size() int {
+func (m *RenderWorkflowTemplateRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListReposRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListReposRequest.DiscardUnknown(m)
+func (m *RenderWorkflowTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderWorkflowTemplateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListReposRequest proto.InternalMessageInfo
+var xxx_messageInfo_RenderWorkflowTemplateRequest proto.InternalMessageInfo
 
-type RepoInfo struct {
+func (m *RenderWorkflowTemplateRequest) GetWorkflowTemplate() string {
+	if m != nil {
+		return m.WorkflowTemplate
+	}
+	return ""
+}
+
+func (m *RenderWorkflowTemplateRequest) GetVars() map[string]string {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
+}
+
+type RenderedChannel struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Default              bool     `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Target               string   `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RepoInfo) Reset()         { *m = RepoInfo{} }
-func (m *RepoInfo) String() string { return proto.CompactTextString(m) }
-func (*RepoInfo) ProtoMessage()    {}
-func (*RepoInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{42}
+func (m *RenderedChannel) Reset()         { *m = RenderedChannel{} }
+func (m *RenderedChannel) String() string { return proto.CompactTextString(m) }
+func (*RenderedChannel) ProtoMessage()    {}
+func (*RenderedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{50}
 }
-func (m *RepoInfo) XXX_Unmarshal(b []byte) error {
+func (m *RenderedChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RepoInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenderedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_RepoInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenderedChannel.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2577,51 +3143,65 @@ func (m *RepoInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *RepoInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RepoInfo.Merge(m, src)
+func (m *RenderedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderedChannel.Merge(m, src)
 }
-func (m *RepoInfo) XXX_Size() int {
+func (m *RenderedChannel) XXX_Size() int {
 	return m.Size()
 }
-func (m *RepoInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_RepoInfo.DiscardUnknown(m)
+func (m *RenderedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderedChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RepoInfo proto.InternalMessageInfo
+var xxx_messageInfo_RenderedChannel proto.InternalMessageInfo
 
-func (m *RepoInfo) GetName() string {
+func (m *RenderedChannel) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *RepoInfo) GetDefault() bool {
+func (m *RenderedChannel) GetType() string {
 	if m != nil {
-		return m.Default
+		return m.Type
 	}
-	return false
+	return ""
 }
 
-type ListReposReply struct {
-	Repos                []*RepoInfo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *RenderedChannel) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
 }
 
-func (m *ListReposReply) Reset()         { *m = ListReposReply{} }
-func (m *ListReposReply) String() string { return proto.CompactTextString(m) }
-func (*ListReposReply) ProtoMessage()    {}
-func (*ListReposReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{43}
+type RenderedRole struct {
+	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	FullPath             string             `protobuf:"bytes,2,opt,name=fullPath,proto3" json:"fullPath,omitempty"`
+	TaskClass            string             `protobuf:"bytes,3,opt,name=taskClass,proto3" json:"taskClass,omitempty"`
+	Vars                 map[string]string  `protobuf:"bytes,4,rep,name=vars,proto3" json:"vars,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	OutboundChannels     []*RenderedChannel `protobuf:"bytes,5,rep,name=outboundChannels,proto3" json:"outboundChannels,omitempty"`
+	Roles                []*RenderedRole    `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	Pruned               bool               `protobuf:"varint,7,opt,name=pruned,proto3" json:"pruned,omitempty"`
+	PruneReason          string             `protobuf:"bytes,8,opt,name=pruneReason,proto3" json:"pruneReason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
-func (m *ListReposReply) XXX_Unmarshal(b []byte) error {
+
+func (m *RenderedRole) Reset()         { *m = RenderedRole{} }
+func (m *RenderedRole) String() string { return proto.CompactTextString(m) }
+func (*RenderedRole) ProtoMessage()    {}
+func (*RenderedRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{51}
+}
+func (m *RenderedRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListReposReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenderedRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListReposReply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenderedRole.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2631,91 +3211,95 @@ func (m *ListReposReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ListReposReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListReposReply.Merge(m, src)
+func (m *RenderedRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderedRole.Merge(m, src)
 }
-func (m *ListReposReply) XXX_Size() int {
+func (m *RenderedRole) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListReposReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListReposReply.DiscardUnknown(m)
+func (m *RenderedRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderedRole.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListReposReply proto.InternalMessageInfo
+var xxx_messageInfo_RenderedRole proto.InternalMessageInfo
 
-func (m *ListReposReply) GetRepos() []*RepoInfo {
+func (m *RenderedRole) GetName() string {
 	if m != nil {
-		return m.Repos
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type AddRepoRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RenderedRole) GetFullPath() string {
+	if m != nil {
+		return m.FullPath
+	}
+	return ""
 }
 
-func (m *AddRepoRequest) Reset()         { *m = AddRepoRequest{} }
-func (m *AddRepoRequest) String() string { return proto.CompactTextString(m) }
-func (*AddRepoRequest) ProtoMessage()    {}
-func (*AddRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{44}
-}
-func (m *AddRepoRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *AddRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AddRepoRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *RenderedRole) GetTaskClass() string {
+	if m != nil {
+		return m.TaskClass
 	}
+	return ""
 }
-func (m *AddRepoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddRepoRequest.Merge(m, src)
+
+func (m *RenderedRole) GetVars() map[string]string {
+	if m != nil {
+		return m.Vars
+	}
+	return nil
 }
-func (m *AddRepoRequest) XXX_Size() int {
-	return m.Size()
+
+func (m *RenderedRole) GetOutboundChannels() []*RenderedChannel {
+	if m != nil {
+		return m.OutboundChannels
+	}
+	return nil
 }
-func (m *AddRepoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddRepoRequest.DiscardUnknown(m)
+
+func (m *RenderedRole) GetRoles() []*RenderedRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
 }
 
-var xxx_messageInfo_AddRepoRequest proto.InternalMessageInfo
+func (m *RenderedRole) GetPruned() bool {
+	if m != nil {
+		return m.Pruned
+	}
+	return false
+}
 
-func (m *AddRepoRequest) GetName() string {
+func (m *RenderedRole) GetPruneReason() string {
 	if m != nil {
-		return m.Name
+		return m.PruneReason
 	}
 	return ""
 }
 
-type AddRepoReply struct {
-	ErrorString          string   `protobuf:"bytes,1,opt,name=errorString,proto3" json:"errorString,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RenderWorkflowTemplateReply struct {
+	WorkflowRevision     string        `protobuf:"bytes,1,opt,name=workflowRevision,proto3" json:"workflowRevision,omitempty"`
+	Workflow             *RenderedRole `protobuf:"bytes,2,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	Problems             []string      `protobuf:"bytes,3,rep,name=problems,proto3" json:"problems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *AddRepoReply) Reset()         { *m = AddRepoReply{} }
-func (m *AddRepoReply) String() string { return proto.CompactTextString(m) }
-func (*AddRepoReply) ProtoMessage()    {}
-func (*AddRepoReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{45}
+func (m *RenderWorkflowTemplateReply) Reset()         { *m = RenderWorkflowTemplateReply{} }
+func (m *RenderWorkflowTemplateReply) String() string { return proto.CompactTextString(m) }
+func (*RenderWorkflowTemplateReply) ProtoMessage()    {}
+func (*RenderWorkflowTemplateReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{52}
 }
-func (m *AddRepoReply) XXX_Unmarshal(b []byte) error {
+func (m *RenderWorkflowTemplateReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AddRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RenderWorkflowTemplateReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AddRepoReply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RenderWorkflowTemplateReply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2725,28 +3309,285 @@ func (m *AddRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AddRepoReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddRepoReply.Merge(m, src)
+func (m *RenderWorkflowTemplateReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenderWorkflowTemplateReply.Merge(m, src)
 }
-func (m *AddRepoReply) XXX_Size() int {
+func (m *RenderWorkflowTemplateReply) XXX_Size() int {
 	return m.Size()
 }
-func (m *AddRepoReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddRepoReply.DiscardUnknown(m)
+func (m *RenderWorkflowTemplateReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenderWorkflowTemplateReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddRepoReply proto.InternalMessageInfo
+var xxx_messageInfo_RenderWorkflowTemplateReply proto.InternalMessageInfo
 
-func (m *AddRepoReply) GetErrorString() string {
+func (m *RenderWorkflowTemplateReply) GetWorkflowRevision() string {
 	if m != nil {
-		return m.ErrorString
+		return m.WorkflowRevision
 	}
 	return ""
 }
 
-type RemoveRepoRequest struct {
-	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+func (m *RenderWorkflowTemplateReply) GetWorkflow() *RenderedRole {
+	if m != nil {
+		return m.Workflow
+	}
+	return nil
+}
+
+func (m *RenderWorkflowTemplateReply) GetProblems() []string {
+	if m != nil {
+		return m.Problems
+	}
+	return nil
+}
+
+type ListReposRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReposRequest) Reset()         { *m = ListReposRequest{} }
+func (m *ListReposRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReposRequest) ProtoMessage()    {}
+func (*ListReposRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{53}
+}
+func (m *ListReposRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListReposRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListReposRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListReposRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReposRequest.Merge(m, src)
+}
+func (m *ListReposRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListReposRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReposRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReposRequest proto.InternalMessageInfo
+
+type RepoInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Default              bool     `protobuf:"varint,2,opt,name=default,proto3" json:"default,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RepoInfo) Reset()         { *m = RepoInfo{} }
+func (m *RepoInfo) String() string { return proto.CompactTextString(m) }
+func (*RepoInfo) ProtoMessage()    {}
+func (*RepoInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{54}
+}
+func (m *RepoInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepoInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepoInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepoInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepoInfo.Merge(m, src)
+}
+func (m *RepoInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepoInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepoInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepoInfo proto.InternalMessageInfo
+
+func (m *RepoInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RepoInfo) GetDefault() bool {
+	if m != nil {
+		return m.Default
+	}
+	return false
+}
+
+type ListReposReply struct {
+	Repos                []*RepoInfo `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListReposReply) Reset()         { *m = ListReposReply{} }
+func (m *ListReposReply) String() string { return proto.CompactTextString(m) }
+func (*ListReposReply) ProtoMessage()    {}
+func (*ListReposReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{55}
+}
+func (m *ListReposReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListReposReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListReposReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListReposReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReposReply.Merge(m, src)
+}
+func (m *ListReposReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListReposReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReposReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReposReply proto.InternalMessageInfo
+
+func (m *ListReposReply) GetRepos() []*RepoInfo {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+type AddRepoRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddRepoRequest) Reset()         { *m = AddRepoRequest{} }
+func (m *AddRepoRequest) String() string { return proto.CompactTextString(m) }
+func (*AddRepoRequest) ProtoMessage()    {}
+func (*AddRepoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{56}
+}
+func (m *AddRepoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddRepoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddRepoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddRepoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRepoRequest.Merge(m, src)
+}
+func (m *AddRepoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddRepoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRepoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddRepoRequest proto.InternalMessageInfo
+
+func (m *AddRepoRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type AddRepoReply struct {
+	ErrorString          string   `protobuf:"bytes,1,opt,name=errorString,proto3" json:"errorString,omitempty"`
+	ValidationErrors     []string `protobuf:"bytes,2,rep,name=validationErrors,proto3" json:"validationErrors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddRepoReply) Reset()         { *m = AddRepoReply{} }
+func (m *AddRepoReply) String() string { return proto.CompactTextString(m) }
+func (*AddRepoReply) ProtoMessage()    {}
+func (*AddRepoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{57}
+}
+func (m *AddRepoReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddRepoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddRepoReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddRepoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRepoReply.Merge(m, src)
+}
+func (m *AddRepoReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddRepoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRepoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddRepoReply proto.InternalMessageInfo
+
+func (m *AddRepoReply) GetErrorString() string {
+	if m != nil {
+		return m.ErrorString
+	}
+	return ""
+}
+
+func (m *AddRepoReply) GetValidationErrors() []string {
+	if m != nil {
+		return m.ValidationErrors
+	}
+	return nil
+}
+
+type RemoveRepoRequest struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -2755,7 +3596,7 @@ func (m *RemoveRepoRequest) Reset()         { *m = RemoveRepoRequest{} }
 func (m *RemoveRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveRepoRequest) ProtoMessage()    {}
 func (*RemoveRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{46}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{58}
 }
 func (m *RemoveRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2803,7 +3644,7 @@ func (m *RemoveRepoReply) Reset()         { *m = RemoveRepoReply{} }
 func (m *RemoveRepoReply) String() string { return proto.CompactTextString(m) }
 func (*RemoveRepoReply) ProtoMessage()    {}
 func (*RemoveRepoReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{47}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{59}
 }
 func (m *RemoveRepoReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2857,7 +3698,7 @@ func (m *RefreshReposRequest) Reset()         { *m = RefreshReposRequest{} }
 func (m *RefreshReposRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshReposRequest) ProtoMessage()    {}
 func (*RefreshReposRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{48}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{60}
 }
 func (m *RefreshReposRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,6 +3736,7 @@ func (m *RefreshReposRequest) GetIndex() int32 {
 
 type RefreshReposReply struct {
 	ErrorString          string   `protobuf:"bytes,1,opt,name=errorString,proto3" json:"errorString,omitempty"`
+	ValidationErrors     []string `protobuf:"bytes,2,rep,name=validationErrors,proto3" json:"validationErrors,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2904,7 +3746,7 @@ func (m *RefreshReposReply) Reset()         { *m = RefreshReposReply{} }
 func (m *RefreshReposReply) String() string { return proto.CompactTextString(m) }
 func (*RefreshReposReply) ProtoMessage()    {}
 func (*RefreshReposReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{49}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{61}
 }
 func (m *RefreshReposReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2940,9 +3782,16 @@ func (m *RefreshReposReply) GetErrorString() string {
 	return ""
 }
 
-type SetDefaultRepoRequest struct {
-	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+func (m *RefreshReposReply) GetValidationErrors() []string {
+	if m != nil {
+		return m.ValidationErrors
+	}
+	return nil
+}
+
+type SetDefaultRepoRequest struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
@@ -2951,7 +3800,7 @@ func (m *SetDefaultRepoRequest) Reset()         { *m = SetDefaultRepoRequest{} }
 func (m *SetDefaultRepoRequest) String() string { return proto.CompactTextString(m) }
 func (*SetDefaultRepoRequest) ProtoMessage()    {}
 func (*SetDefaultRepoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{50}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{62}
 }
 func (m *SetDefaultRepoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2998,7 +3847,7 @@ func (m *SetDefaultRepoReply) Reset()         { *m = SetDefaultRepoReply{} }
 func (m *SetDefaultRepoReply) String() string { return proto.CompactTextString(m) }
 func (*SetDefaultRepoReply) ProtoMessage()    {}
 func (*SetDefaultRepoReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2aa6aa9a1f02efa9, []int{51}
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{63}
 }
 func (m *SetDefaultRepoReply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3034,6 +3883,219 @@ func (m *SetDefaultRepoReply) GetErrorString() string {
 	return ""
 }
 
+type GetRunConfigurationRequest struct {
+	RunNumber            uint32   `protobuf:"varint,1,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRunConfigurationRequest) Reset()         { *m = GetRunConfigurationRequest{} }
+func (m *GetRunConfigurationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRunConfigurationRequest) ProtoMessage()    {}
+func (*GetRunConfigurationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{64}
+}
+func (m *GetRunConfigurationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRunConfigurationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRunConfigurationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetRunConfigurationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRunConfigurationRequest.Merge(m, src)
+}
+func (m *GetRunConfigurationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRunConfigurationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRunConfigurationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRunConfigurationRequest proto.InternalMessageInfo
+
+func (m *GetRunConfigurationRequest) GetRunNumber() uint32 {
+	if m != nil {
+		return m.RunNumber
+	}
+	return 0
+}
+
+type RunTaskConfiguration struct {
+	TaskId               string            `protobuf:"bytes,1,opt,name=taskId,proto3" json:"taskId,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ClassName            string            `protobuf:"bytes,3,opt,name=className,proto3" json:"className,omitempty"`
+	Hostname             string            `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Properties           map[string]string `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RunTaskConfiguration) Reset()         { *m = RunTaskConfiguration{} }
+func (m *RunTaskConfiguration) String() string { return proto.CompactTextString(m) }
+func (*RunTaskConfiguration) ProtoMessage()    {}
+func (*RunTaskConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{65}
+}
+func (m *RunTaskConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RunTaskConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RunTaskConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RunTaskConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunTaskConfiguration.Merge(m, src)
+}
+func (m *RunTaskConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *RunTaskConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunTaskConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunTaskConfiguration proto.InternalMessageInfo
+
+func (m *RunTaskConfiguration) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *RunTaskConfiguration) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RunTaskConfiguration) GetClassName() string {
+	if m != nil {
+		return m.ClassName
+	}
+	return ""
+}
+
+func (m *RunTaskConfiguration) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *RunTaskConfiguration) GetProperties() map[string]string {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+type GetRunConfigurationReply struct {
+	RunNumber            uint32                  `protobuf:"varint,1,opt,name=runNumber,proto3" json:"runNumber,omitempty"`
+	EnvironmentId        string                  `protobuf:"bytes,2,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	WorkflowTemplate     string                  `protobuf:"bytes,3,opt,name=workflowTemplate,proto3" json:"workflowTemplate,omitempty"`
+	WorkflowRevision     string                  `protobuf:"bytes,4,opt,name=workflowRevision,proto3" json:"workflowRevision,omitempty"`
+	StartedWhen          string                  `protobuf:"bytes,5,opt,name=startedWhen,proto3" json:"startedWhen,omitempty"`
+	Tasks                []*RunTaskConfiguration `protobuf:"bytes,6,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetRunConfigurationReply) Reset()         { *m = GetRunConfigurationReply{} }
+func (m *GetRunConfigurationReply) String() string { return proto.CompactTextString(m) }
+func (*GetRunConfigurationReply) ProtoMessage()    {}
+func (*GetRunConfigurationReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aa6aa9a1f02efa9, []int{66}
+}
+func (m *GetRunConfigurationReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRunConfigurationReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRunConfigurationReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetRunConfigurationReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRunConfigurationReply.Merge(m, src)
+}
+func (m *GetRunConfigurationReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRunConfigurationReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRunConfigurationReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRunConfigurationReply proto.InternalMessageInfo
+
+func (m *GetRunConfigurationReply) GetRunNumber() uint32 {
+	if m != nil {
+		return m.RunNumber
+	}
+	return 0
+}
+
+func (m *GetRunConfigurationReply) GetEnvironmentId() string {
+	if m != nil {
+		return m.EnvironmentId
+	}
+	return ""
+}
+
+func (m *GetRunConfigurationReply) GetWorkflowTemplate() string {
+	if m != nil {
+		return m.WorkflowTemplate
+	}
+	return ""
+}
+
+func (m *GetRunConfigurationReply) GetWorkflowRevision() string {
+	if m != nil {
+		return m.WorkflowRevision
+	}
+	return ""
+}
+
+func (m *GetRunConfigurationReply) GetStartedWhen() string {
+	if m != nil {
+		return m.StartedWhen
+	}
+	return ""
+}
+
+func (m *GetRunConfigurationReply) GetTasks() []*RunTaskConfiguration {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("o2control.StatusUpdate_Level", StatusUpdate_Level_name, StatusUpdate_Level_value)
 	proto.RegisterEnum("o2control.ControlEnvironmentRequest_Optype", ControlEnvironmentRequest_Optype_name, ControlEnvironmentRequest_Optype_value)
@@ -3047,13 +4109,21 @@ func init() {
 	proto.RegisterType((*GetFrameworkInfoReply)(nil), "o2control.GetFrameworkInfoReply")
 	proto.RegisterType((*TeardownRequest)(nil), "o2control.TeardownRequest")
 	proto.RegisterType((*TeardownReply)(nil), "o2control.TeardownReply")
+	proto.RegisterType((*RefreshConfigRequest)(nil), "o2control.RefreshConfigRequest")
+	proto.RegisterType((*SettingChange)(nil), "o2control.SettingChange")
+	proto.RegisterType((*RefreshConfigReply)(nil), "o2control.RefreshConfigReply")
 	proto.RegisterType((*GetEnvironmentsRequest)(nil), "o2control.GetEnvironmentsRequest")
 	proto.RegisterType((*GetEnvironmentsReply)(nil), "o2control.GetEnvironmentsReply")
 	proto.RegisterType((*EnvironmentInfo)(nil), "o2control.EnvironmentInfo")
 	proto.RegisterType((*NewEnvironmentRequest)(nil), "o2control.NewEnvironmentRequest")
+	proto.RegisterMapType((map[string]string)(nil), "o2control.NewEnvironmentRequest.VarsEntry")
 	proto.RegisterType((*NewEnvironmentReply)(nil), "o2control.NewEnvironmentReply")
 	proto.RegisterType((*GetEnvironmentRequest)(nil), "o2control.GetEnvironmentRequest")
 	proto.RegisterType((*GetEnvironmentReply)(nil), "o2control.GetEnvironmentReply")
+	proto.RegisterType((*GetEnvironmentTopologyRequest)(nil), "o2control.GetEnvironmentTopologyRequest")
+	proto.RegisterType((*TopologyNode)(nil), "o2control.TopologyNode")
+	proto.RegisterType((*TopologyEdge)(nil), "o2control.TopologyEdge")
+	proto.RegisterType((*GetEnvironmentTopologyReply)(nil), "o2control.GetEnvironmentTopologyReply")
 	proto.RegisterType((*ControlEnvironmentRequest)(nil), "o2control.ControlEnvironmentRequest")
 	proto.RegisterType((*ControlEnvironmentReply)(nil), "o2control.ControlEnvironmentReply")
 	proto.RegisterType((*ModifyEnvironmentRequest)(nil), "o2control.ModifyEnvironmentRequest")
@@ -3075,10 +4145,17 @@ func init() {
 	proto.RegisterType((*CleanupTasksReply)(nil), "o2control.CleanupTasksReply")
 	proto.RegisterType((*GetRolesRequest)(nil), "o2control.GetRolesRequest")
 	proto.RegisterType((*RoleInfo)(nil), "o2control.RoleInfo")
+	proto.RegisterType((*VarInfo)(nil), "o2control.VarInfo")
 	proto.RegisterType((*GetRolesReply)(nil), "o2control.GetRolesReply")
 	proto.RegisterType((*GetWorkflowTemplatesRequest)(nil), "o2control.GetWorkflowTemplatesRequest")
 	proto.RegisterType((*WorkflowTemplateInfo)(nil), "o2control.WorkflowTemplateInfo")
 	proto.RegisterType((*GetWorkflowTemplatesReply)(nil), "o2control.GetWorkflowTemplatesReply")
+	proto.RegisterType((*RenderWorkflowTemplateRequest)(nil), "o2control.RenderWorkflowTemplateRequest")
+	proto.RegisterMapType((map[string]string)(nil), "o2control.RenderWorkflowTemplateRequest.VarsEntry")
+	proto.RegisterType((*RenderedChannel)(nil), "o2control.RenderedChannel")
+	proto.RegisterType((*RenderedRole)(nil), "o2control.RenderedRole")
+	proto.RegisterMapType((map[string]string)(nil), "o2control.RenderedRole.VarsEntry")
+	proto.RegisterType((*RenderWorkflowTemplateReply)(nil), "o2control.RenderWorkflowTemplateReply")
 	proto.RegisterType((*ListReposRequest)(nil), "o2control.ListReposRequest")
 	proto.RegisterType((*RepoInfo)(nil), "o2control.RepoInfo")
 	proto.RegisterType((*ListReposReply)(nil), "o2control.ListReposReply")
@@ -3090,147 +4167,197 @@ func init() {
 	proto.RegisterType((*RefreshReposReply)(nil), "o2control.RefreshReposReply")
 	proto.RegisterType((*SetDefaultRepoRequest)(nil), "o2control.SetDefaultRepoRequest")
 	proto.RegisterType((*SetDefaultRepoReply)(nil), "o2control.SetDefaultRepoReply")
+	proto.RegisterType((*GetRunConfigurationRequest)(nil), "o2control.GetRunConfigurationRequest")
+	proto.RegisterType((*RunTaskConfiguration)(nil), "o2control.RunTaskConfiguration")
+	proto.RegisterMapType((map[string]string)(nil), "o2control.RunTaskConfiguration.PropertiesEntry")
+	proto.RegisterType((*GetRunConfigurationReply)(nil), "o2control.GetRunConfigurationReply")
 }
 
 func init() { proto.RegisterFile("protos/o2control.proto", fileDescriptor_2aa6aa9a1f02efa9) }
 
 var fileDescriptor_2aa6aa9a1f02efa9 = []byte{
-	// 2159 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xd7, 0xc8, 0x92, 0x25, 0x3d, 0xc9, 0xb2, 0xdc, 0x76, 0xec, 0xb1, 0xd6, 0xeb, 0x78, 0x9b,
-	0x54, 0x36, 0xd9, 0x5d, 0x9c, 0xc5, 0x0b, 0x6c, 0x2a, 0x2c, 0x04, 0x5b, 0x56, 0x1c, 0x41, 0x6c,
-	0xa5, 0x46, 0x4a, 0x52, 0x6c, 0x15, 0x15, 0xc6, 0x9a, 0x96, 0xa5, 0xf5, 0x68, 0x5a, 0xcc, 0x1f,
-	0x3b, 0x3e, 0x70, 0xe3, 0x46, 0x51, 0x7b, 0xe0, 0xc2, 0x95, 0xe2, 0xcc, 0xf7, 0xe0, 0x42, 0x01,
-	0xdf, 0x80, 0x0a, 0x55, 0x9c, 0xf8, 0x10, 0x54, 0xff, 0x99, 0x99, 0x9e, 0xd1, 0xc8, 0x76, 0x15,
-	0x37, 0xbd, 0xd7, 0xbf, 0xf7, 0xba, 0xdf, 0x9f, 0x7e, 0xef, 0xf5, 0x08, 0xd6, 0xa7, 0x2e, 0xf5,
-	0xa9, 0xf7, 0x88, 0xee, 0x0d, 0xa8, 0xe3, 0xbb, 0xd4, 0xde, 0xe5, 0x0c, 0x54, 0x89, 0x18, 0x78,
-	0x1d, 0xd6, 0xda, 0x17, 0xc4, 0xf1, 0xdf, 0x1e, 0x13, 0x8f, 0x7a, 0xcf, 0x89, 0xe9, 0xfa, 0xa7,
-	0xc4, 0xf4, 0xf1, 0x32, 0x2c, 0xf5, 0x7c, 0xd3, 0x0f, 0x3c, 0x83, 0xfc, 0x3a, 0x20, 0x9e, 0x8f,
-	0x4f, 0xa1, 0x1a, 0x32, 0xa6, 0xf6, 0x15, 0x5a, 0x83, 0xa2, 0xe7, 0x9b, 0x3e, 0xd1, 0xb5, 0x1d,
-	0xed, 0x41, 0xc5, 0x10, 0x04, 0xfa, 0x31, 0x2c, 0x79, 0x1c, 0xf4, 0x6a, 0x6a, 0x99, 0x3e, 0xf1,
-	0xf4, 0xfc, 0xce, 0xc2, 0x83, 0xea, 0xde, 0xc6, 0x6e, 0x7c, 0x82, 0x9e, 0xb2, 0x6e, 0x24, 0xd1,
-	0xf8, 0x6f, 0x1a, 0xd4, 0xd4, 0x75, 0xf4, 0x05, 0x14, 0x6d, 0x72, 0x41, 0x6c, 0xbe, 0x4b, 0x7d,
-	0xef, 0xc3, 0x39, 0x7a, 0x76, 0x5f, 0x30, 0x90, 0x21, 0xb0, 0xa8, 0x03, 0xf5, 0x49, 0xc2, 0x18,
-	0x3d, 0xbf, 0xa3, 0x3d, 0xa8, 0xee, 0xdd, 0x55, 0xa4, 0xb3, 0x6c, 0x7e, 0x9e, 0x33, 0x52, 0x82,
-	0xf8, 0xfb, 0x50, 0xe4, 0xaa, 0x51, 0x05, 0x8a, 0x87, 0xed, 0x83, 0x57, 0x47, 0x8d, 0x1c, 0x2a,
-	0x43, 0xa1, 0x73, 0xf2, 0xac, 0xdb, 0xd0, 0x50, 0x15, 0x4a, 0x6f, 0xf6, 0x8d, 0x93, 0xce, 0xc9,
-	0x51, 0x23, 0xcf, 0x10, 0x6d, 0xc3, 0xe8, 0x1a, 0x8d, 0x85, 0x83, 0x12, 0x14, 0xb9, 0x7e, 0xbc,
-	0x09, 0x1b, 0x47, 0xc4, 0x7f, 0xe6, 0x9a, 0x13, 0x72, 0x49, 0xdd, 0xf3, 0x8e, 0x33, 0xa4, 0xa1,
-	0x3b, 0xff, 0xac, 0x41, 0xe9, 0x35, 0x71, 0xbd, 0x31, 0x75, 0x98, 0x2f, 0x27, 0xe6, 0x37, 0xd4,
-	0xe5, 0x56, 0x16, 0x0d, 0x41, 0x70, 0xee, 0xd8, 0xa1, 0x2e, 0x3f, 0x3d, 0xe3, 0x32, 0x82, 0x71,
-	0xa7, 0xa6, 0x3f, 0x18, 0xe9, 0x0b, 0x82, 0xcb, 0x09, 0xc6, 0x3d, 0x0d, 0xc6, 0xb6, 0xa5, 0x17,
-	0x44, 0x34, 0x38, 0x81, 0x76, 0xa0, 0x3a, 0x75, 0xa9, 0x15, 0x0c, 0xfc, 0x13, 0x73, 0x42, 0xf4,
-	0x22, 0x5f, 0x53, 0x59, 0x68, 0x1b, 0xe0, 0x42, 0x1c, 0xa2, 0xe7, 0xbb, 0xfa, 0x22, 0x07, 0x28,
-	0x1c, 0xfc, 0x6d, 0x1e, 0xee, 0xcc, 0x5a, 0xc0, 0xe2, 0xbf, 0x03, 0xd5, 0x61, 0xc4, 0xb5, 0x64,
-	0x16, 0xa8, 0x2c, 0xf4, 0x19, 0xac, 0x10, 0xe7, 0x62, 0xec, 0x52, 0x67, 0x42, 0x1c, 0xdf, 0x6b,
-	0xd1, 0xc0, 0xf1, 0xa5, 0x2d, 0xb3, 0x0b, 0xec, 0x24, 0xbe, 0xe9, 0x9d, 0x4b, 0x98, 0x30, 0x4e,
-	0xe1, 0xc4, 0xf9, 0x56, 0x50, 0xf3, 0x6d, 0x1b, 0x60, 0x44, 0xbd, 0x50, 0x79, 0x51, 0x48, 0xc5,
-	0x1c, 0x84, 0xa1, 0x36, 0x76, 0x3c, 0xdf, 0x74, 0x06, 0x84, 0xbb, 0x40, 0x58, 0x98, 0xe0, 0xa1,
-	0xcf, 0xa0, 0x24, 0x2d, 0xd6, 0x4b, 0x3c, 0x4f, 0x90, 0x92, 0x27, 0x32, 0x44, 0x46, 0x08, 0xc1,
-	0x0f, 0x61, 0xb9, 0x4f, 0x4c, 0xd7, 0xa2, 0x97, 0x8e, 0x0c, 0x25, 0x5a, 0x87, 0x45, 0x97, 0x98,
-	0x1e, 0x75, 0xa4, 0x17, 0x24, 0xc5, 0xae, 0x50, 0x0c, 0x9d, 0xda, 0x57, 0x58, 0x87, 0xf5, 0x23,
-	0xe2, 0xb7, 0x15, 0xdb, 0xc3, 0x6c, 0x78, 0x07, 0x6b, 0x33, 0x2b, 0xb7, 0xf3, 0xf2, 0x4f, 0xa0,
-	0xa6, 0x3a, 0x53, 0x5e, 0xb8, 0xa6, 0x9a, 0xea, 0xf1, 0x32, 0x0f, 0x5f, 0x02, 0x8f, 0xff, 0xa9,
-	0xc1, 0x72, 0x0a, 0x81, 0xea, 0x90, 0x1f, 0x87, 0x9b, 0xe5, 0xc7, 0x3c, 0x8f, 0x06, 0x2e, 0x31,
-	0x7d, 0x62, 0xbd, 0x19, 0x11, 0x87, 0xc7, 0xb0, 0x62, 0xa8, 0xac, 0x38, 0x3a, 0x0b, 0x6a, 0x74,
-	0x76, 0xa1, 0xc8, 0x23, 0xa8, 0x17, 0xf8, 0xa1, 0x74, 0xf5, 0xf6, 0x8e, 0xa8, 0xeb, 0xf7, 0x4d,
-	0x4f, 0x64, 0x94, 0x80, 0xa1, 0x26, 0x94, 0x5d, 0x4a, 0x7d, 0x83, 0xda, 0x61, 0xb2, 0x46, 0x34,
-	0xfa, 0x04, 0x1a, 0x83, 0xc0, 0x75, 0x89, 0xe3, 0x1b, 0x81, 0x73, 0x12, 0x4c, 0x4e, 0x89, 0xc8,
-	0xd7, 0x25, 0x63, 0x86, 0x8f, 0x5b, 0x70, 0xe7, 0x84, 0x5c, 0x2a, 0x56, 0x85, 0x91, 0xfa, 0x04,
-	0x1a, 0xcc, 0x6d, 0x43, 0x9b, 0x5e, 0xf6, 0xc9, 0x64, 0x6a, 0xc7, 0xf5, 0x6b, 0x86, 0x8f, 0x7b,
-	0xb0, 0x9a, 0x56, 0xc2, 0x22, 0xf2, 0x15, 0x54, 0x15, 0xff, 0x71, 0xe9, 0xeb, 0xdd, 0xad, 0xc2,
-	0xf1, 0xc7, 0xfc, 0x3a, 0x65, 0x9c, 0x2c, 0xe5, 0x72, 0xfc, 0x5b, 0x0d, 0x56, 0xd3, 0xc8, 0xff,
-	0x7b, 0x7b, 0xf4, 0x08, 0xca, 0xa1, 0x9d, 0xb2, 0x26, 0xae, 0x2a, 0xa2, 0xcc, 0xcf, 0x5c, 0x26,
-	0x02, 0xe1, 0xbf, 0x6b, 0xb0, 0xd9, 0x12, 0xcb, 0x37, 0x1f, 0x1a, 0x3d, 0x85, 0x82, 0x7f, 0x35,
-	0x25, 0x5c, 0x75, 0x7d, 0xef, 0x53, 0x45, 0xf5, 0x5c, 0x1d, 0xbb, 0xdd, 0x29, 0x13, 0x31, 0xb8,
-	0x20, 0x36, 0x61, 0x51, 0xd0, 0xac, 0xc8, 0x9e, 0x74, 0xbb, 0x2f, 0x1b, 0x39, 0x84, 0xa0, 0xde,
-	0xeb, 0xef, 0x1b, 0xfd, 0xb7, 0xfb, 0xad, 0x7e, 0xe7, 0x75, 0xa7, 0xff, 0x8b, 0x86, 0x86, 0x56,
-	0x60, 0xa9, 0xd7, 0xef, 0xbe, 0x8c, 0x59, 0x79, 0xb4, 0x04, 0x95, 0x56, 0xf7, 0xe4, 0x59, 0xe7,
-	0xe8, 0x95, 0xd1, 0x6e, 0x2c, 0xb0, 0x6a, 0x6c, 0xb4, 0x7b, 0xed, 0x7e, 0xa3, 0x80, 0x6a, 0x50,
-	0x3e, 0xea, 0xbe, 0x15, 0xb5, 0xb9, 0x88, 0xcf, 0x61, 0x23, 0xeb, 0x30, 0xcc, 0xb7, 0x69, 0x73,
-	0xa2, 0xa4, 0xce, 0xab, 0x49, 0x9d, 0x95, 0x88, 0x0b, 0x73, 0x12, 0xf1, 0x0f, 0x1a, 0xe8, 0xc7,
-	0xd4, 0x1a, 0x0f, 0xaf, 0x6e, 0xe5, 0x3d, 0xa0, 0x53, 0xe2, 0x9a, 0xfe, 0x98, 0x3a, 0xe1, 0x3d,
-	0xbe, 0x9b, 0x1d, 0xd9, 0x6e, 0x88, 0x33, 0x14, 0x11, 0x74, 0x1f, 0xea, 0x2e, 0x19, 0x50, 0x67,
-	0x38, 0x3e, 0x0b, 0x5c, 0xb2, 0x6f, 0xdb, 0xfc, 0x5c, 0x65, 0x23, 0xc5, 0xc5, 0x7f, 0xd2, 0x60,
-	0x2d, 0x4b, 0x19, 0x7a, 0x22, 0xe3, 0x27, 0x9a, 0xed, 0xfd, 0x1b, 0xf6, 0x4e, 0x84, 0x4e, 0xdc,
-	0x5d, 0x5b, 0x54, 0xd9, 0x7c, 0x78, 0x77, 0x05, 0x8d, 0xbf, 0x97, 0x11, 0xd6, 0x65, 0xa8, 0x1a,
-	0xed, 0xe3, 0xee, 0xeb, 0xf6, 0x5b, 0xa3, 0xfb, 0x82, 0x45, 0xac, 0x06, 0xe5, 0xfd, 0xc3, 0x43,
-	0x41, 0x15, 0xf0, 0xef, 0x34, 0x58, 0xcf, 0xf0, 0x1c, 0x0b, 0xd3, 0xcf, 0xa1, 0x31, 0x34, 0xc7,
-	0x36, 0xb1, 0xba, 0xb1, 0xb7, 0xb4, 0xdb, 0x79, 0x6b, 0x46, 0x50, 0x06, 0x21, 0x3f, 0x1b, 0x73,
-	0xb5, 0x90, 0xe1, 0x0e, 0x6c, 0x1e, 0x12, 0xcf, 0x77, 0xe9, 0x6d, 0xe2, 0xb8, 0x05, 0x95, 0x73,
-	0x42, 0xa6, 0x7d, 0x5e, 0xf9, 0xf2, 0x3c, 0x02, 0x31, 0x03, 0x13, 0xd8, 0xc8, 0x52, 0xc5, 0x0c,
-	0xfb, 0x19, 0xac, 0x0c, 0x6c, 0x62, 0x3a, 0x81, 0x80, 0x72, 0xa6, 0xbc, 0xe1, 0x5b, 0xea, 0x5d,
-	0x4a, 0x63, 0x8c, 0x59, 0x31, 0xfc, 0x1f, 0x0d, 0x96, 0x12, 0x35, 0x16, 0x21, 0x28, 0x38, 0x2c,
-	0x38, 0xe2, 0xa0, 0xfc, 0x37, 0xeb, 0x5c, 0x36, 0x1d, 0x9c, 0x13, 0x4b, 0x9e, 0x53, 0x52, 0x8c,
-	0xcf, 0x2a, 0x72, 0xc7, 0x92, 0x6e, 0x90, 0x14, 0xe3, 0x8b, 0x81, 0x4d, 0x76, 0x61, 0x49, 0xc5,
-	0x5e, 0x2b, 0xaa, 0x37, 0x65, 0x0b, 0x2a, 0x03, 0xdb, 0xf4, 0x3c, 0xa5, 0xf3, 0xc6, 0x0c, 0xd4,
-	0x86, 0xba, 0x45, 0xa6, 0x36, 0xbd, 0x0a, 0x4b, 0x95, 0xec, 0xbe, 0xea, 0x8c, 0xc7, 0x0e, 0x7f,
-	0x98, 0x00, 0x19, 0x29, 0x21, 0x56, 0x28, 0xd1, 0x2c, 0x8c, 0xa5, 0x23, 0x1b, 0x03, 0x14, 0x8b,
-	0x23, 0x1a, 0xe9, 0x50, 0x32, 0xcf, 0x18, 0x30, 0x0c, 0x7c, 0x48, 0xb2, 0x15, 0x3a, 0x1c, 0x12,
-	0x37, 0x32, 0x3c, 0x24, 0xd9, 0xa0, 0x41, 0xde, 0x91, 0x41, 0xe0, 0x53, 0xb6, 0x28, 0xac, 0x57,
-	0x38, 0x78, 0x05, 0x96, 0x8f, 0x88, 0x2f, 0x03, 0x20, 0x7a, 0xfa, 0x53, 0x58, 0x8a, 0x59, 0x2c,
-	0xbe, 0x51, 0x3b, 0xd4, 0x6e, 0xd5, 0x0e, 0xf1, 0x03, 0xa8, 0x4b, 0x05, 0xca, 0xa4, 0x21, 0xe3,
-	0xa2, 0xa9, 0x71, 0xc1, 0x5f, 0x42, 0x2d, 0x42, 0xb2, 0x9d, 0x3e, 0x86, 0x02, 0x5b, 0x91, 0xc9,
-	0xb3, 0x9a, 0xf2, 0x28, 0xdf, 0x83, 0x03, 0x70, 0x1b, 0x96, 0x18, 0xa7, 0xc5, 0xa2, 0x32, 0x37,
-	0x4b, 0x58, 0xfb, 0x17, 0xe2, 0xc7, 0xd4, 0x22, 0x51, 0xfb, 0x8f, 0x59, 0xf8, 0x37, 0x50, 0x6d,
-	0xd1, 0xc9, 0xc4, 0x74, 0x2c, 0xae, 0xa4, 0x01, 0x0b, 0xc4, 0xb9, 0xe0, 0x66, 0x56, 0x0c, 0xf6,
-	0x93, 0x27, 0xc8, 0x88, 0xd8, 0xb6, 0xcc, 0x33, 0x41, 0x30, 0xee, 0x85, 0x69, 0x07, 0xd1, 0x65,
-	0xe3, 0x04, 0x4b, 0x1b, 0xd3, 0x3d, 0x0b, 0xc4, 0x38, 0x53, 0xe0, 0x3a, 0x62, 0x06, 0x3b, 0x60,
-	0xe0, 0x11, 0x57, 0x66, 0x1a, 0xff, 0x8d, 0x8f, 0xa1, 0xda, 0x1a, 0x99, 0x8e, 0x43, 0xec, 0xb9,
-	0x36, 0x20, 0xa5, 0x35, 0x55, 0x64, 0xc9, 0xe2, 0xde, 0x74, 0xcf, 0x88, 0x1f, 0x67, 0x39, 0xa3,
-	0xf0, 0x7f, 0xf3, 0x50, 0x8e, 0xae, 0xcd, 0x0f, 0xa1, 0xe2, 0xb1, 0xe0, 0xf0, 0x0c, 0x15, 0xfe,
-	0x9c, 0x1f, 0xb8, 0x18, 0xca, 0xe4, 0x06, 0xa1, 0x57, 0x65, 0xaf, 0xd5, 0x53, 0x71, 0x88, 0xbc,
-	0x6e, 0xc4, 0x50, 0xf4, 0x53, 0x58, 0x1e, 0x3b, 0xa7, 0x34, 0x70, 0x2c, 0x69, 0x92, 0xa7, 0x2f,
-	0xf0, 0x74, 0x59, 0x57, 0x4b, 0x40, 0x6c, 0xad, 0x91, 0x86, 0xa3, 0x03, 0x68, 0xd0, 0xc0, 0x4f,
-	0xaa, 0x28, 0x5c, 0xab, 0x62, 0x06, 0x8f, 0x1e, 0xb3, 0x90, 0x47, 0x01, 0xe5, 0xce, 0x4e, 0x89,
-	0xc7, 0xab, 0x86, 0x0a, 0x65, 0x17, 0x8f, 0x65, 0xd6, 0x4b, 0xd3, 0x1f, 0xc9, 0x3b, 0x1f, 0xd1,
-	0x2c, 0xde, 0xc4, 0xb9, 0xe8, 0x58, 0xfc, 0xa6, 0x57, 0x0c, 0x41, 0xe0, 0x47, 0xb0, 0x9a, 0x2c,
-	0x69, 0x22, 0xd7, 0x75, 0x28, 0x89, 0xec, 0xf6, 0x64, 0x22, 0x85, 0x24, 0xfe, 0xbd, 0x06, 0x2b,
-	0x33, 0x45, 0x10, 0x3d, 0x81, 0xea, 0xf9, 0xd8, 0xb6, 0x89, 0xd5, 0xbf, 0xd5, 0x1d, 0x53, 0xc1,
-	0xe8, 0x2b, 0xa8, 0xb9, 0x81, 0xe3, 0x8c, 0x9d, 0xb3, 0xb0, 0x6a, 0x5f, 0x2f, 0x9c, 0x40, 0xe3,
-	0x16, 0xbf, 0xfb, 0x6c, 0x7a, 0x8a, 0x0e, 0x1f, 0x59, 0xaa, 0x29, 0x96, 0x32, 0xdf, 0x4c, 0x4d,
-	0x7f, 0xd4, 0x9b, 0x92, 0x41, 0xd8, 0x23, 0x43, 0x1a, 0xff, 0x45, 0x83, 0x72, 0x38, 0x80, 0xcd,
-	0xab, 0xd5, 0xb2, 0xf6, 0xe6, 0xb3, 0x6b, 0x6f, 0x62, 0xf4, 0x6e, 0x42, 0x79, 0x18, 0xd8, 0x36,
-	0x0f, 0x83, 0xa8, 0x56, 0x11, 0xad, 0x7a, 0xb6, 0x98, 0xf0, 0x2c, 0x7a, 0x08, 0x45, 0xd6, 0xb4,
-	0x3d, 0x7d, 0x91, 0x3b, 0x20, 0x73, 0x38, 0x14, 0x08, 0xfc, 0x84, 0x57, 0x37, 0x69, 0x34, 0xf3,
-	0x7f, 0x24, 0xab, 0xdd, 0x28, 0xfb, 0x21, 0x7c, 0x70, 0x44, 0xfc, 0x37, 0xa9, 0x89, 0x3b, 0x2a,
-	0x9c, 0xcf, 0x60, 0x2d, 0xbd, 0x16, 0x7a, 0xc5, 0x25, 0x53, 0x1a, 0x7a, 0x85, 0xfd, 0xe6, 0xe9,
-	0x16, 0x4e, 0xf2, 0xd2, 0xa5, 0x21, 0x8d, 0xbf, 0x81, 0xcd, 0xec, 0x6d, 0xd8, 0x71, 0x8f, 0x61,
-	0x25, 0x3d, 0xf2, 0x67, 0x8d, 0x11, 0x59, 0x07, 0x31, 0x66, 0x25, 0x31, 0x82, 0xc6, 0x8b, 0xb1,
-	0xc7, 0x1a, 0x39, 0x8d, 0xec, 0x78, 0x0c, 0x65, 0x46, 0xcf, 0x8d, 0xa8, 0x0e, 0x25, 0x8b, 0x0c,
-	0xcd, 0xc0, 0xf6, 0x65, 0x59, 0x0c, 0x49, 0xfc, 0x23, 0xa8, 0x2b, 0xda, 0x42, 0xef, 0x32, 0x2a,
-	0xcb, 0xbb, 0x72, 0x0f, 0x43, 0x20, 0xf0, 0x3d, 0xa8, 0xef, 0x5b, 0x16, 0xe3, 0x86, 0xd9, 0x98,
-	0xb1, 0x39, 0xfe, 0x1c, 0x6a, 0x11, 0x4a, 0xbe, 0x34, 0x89, 0xeb, 0x52, 0xb7, 0xe7, 0xbb, 0x63,
-	0xe7, 0x2c, 0x7c, 0x69, 0x2a, 0x2c, 0xfc, 0x10, 0x56, 0x0c, 0x32, 0xa1, 0x17, 0x44, 0x55, 0xbd,
-	0x06, 0xc5, 0xb1, 0x63, 0x91, 0x77, 0xe1, 0xa7, 0x0b, 0x4e, 0xe0, 0x0e, 0x2c, 0xab, 0x50, 0x39,
-	0x5c, 0x53, 0xd1, 0x90, 0xca, 0x46, 0x9e, 0x9e, 0xb3, 0x61, 0xd5, 0x21, 0x97, 0x87, 0xc2, 0x60,
-	0x06, 0x93, 0xe1, 0x4b, 0x71, 0xf1, 0xa7, 0xb0, 0x6a, 0x90, 0xa1, 0x4b, 0xbc, 0x91, 0xea, 0xdb,
-	0x39, 0xfb, 0xfe, 0x80, 0x1d, 0x51, 0x05, 0xdf, 0xce, 0xb2, 0xef, 0xc2, 0x9d, 0x1e, 0xf1, 0x95,
-	0x5d, 0xaf, 0xdf, 0xe5, 0x4b, 0x58, 0x4d, 0xc3, 0x6f, 0xb5, 0xcf, 0xde, 0xb7, 0x35, 0x28, 0xc9,
-	0xc7, 0x07, 0x6a, 0x41, 0xb5, 0xef, 0x9a, 0x83, 0x73, 0xf1, 0x19, 0x0b, 0xe9, 0x33, 0x5f, 0xb6,
-	0xe4, 0x19, 0x9a, 0xeb, 0x19, 0x2b, 0x6c, 0xc2, 0xcb, 0x7d, 0xae, 0xa1, 0xaf, 0xa1, 0x91, 0xfe,
-	0x3a, 0x83, 0xb0, 0x82, 0x9f, 0xf3, 0xf1, 0xa9, 0xb9, 0x73, 0x2d, 0x86, 0x6b, 0x47, 0x07, 0x50,
-	0x0e, 0xbf, 0x5e, 0x20, 0xf5, 0x81, 0x99, 0xfa, 0xfa, 0xd1, 0xd4, 0x33, 0xd7, 0x84, 0x8e, 0x37,
-	0xbc, 0x32, 0xaa, 0x9f, 0x35, 0xd0, 0x47, 0xc9, 0xad, 0x33, 0x3e, 0x86, 0x34, 0xef, 0x5e, 0x07,
-	0x11, 0x8a, 0xfb, 0x50, 0x4f, 0x3e, 0xce, 0x91, 0x6a, 0x52, 0xe6, 0xe3, 0xbf, 0xb9, 0x7d, 0x0d,
-	0x22, 0xd2, 0x9a, 0xdc, 0x0f, 0xed, 0xcc, 0x3d, 0x4a, 0x96, 0xd6, 0x8c, 0x07, 0x3b, 0xce, 0xa1,
-	0x5f, 0x01, 0x9a, 0x7d, 0x71, 0xa2, 0x7b, 0xb7, 0x79, 0x1d, 0x37, 0xf1, 0x0d, 0x28, 0xb1, 0xc3,
-	0x2f, 0x61, 0x65, 0xe6, 0xad, 0x84, 0xbe, 0xa3, 0x88, 0xce, 0x7b, 0x83, 0x36, 0x3f, 0xba, 0x1e,
-	0x14, 0x19, 0x30, 0xfb, 0x64, 0x49, 0x18, 0x30, 0xf7, 0x71, 0x94, 0x30, 0x60, 0xce, 0xbb, 0x47,
-	0xe4, 0x5a, 0x38, 0x2a, 0x27, 0x72, 0x2d, 0x35, 0x52, 0x27, 0x72, 0x2d, 0x31, 0x5b, 0xe3, 0x1c,
-	0x7a, 0x0a, 0x25, 0xc9, 0x42, 0x9b, 0xb3, 0xb0, 0x50, 0xc3, 0x46, 0xd6, 0x92, 0x50, 0x70, 0x02,
-	0x35, 0x75, 0xaa, 0x40, 0xdb, 0x73, 0xdf, 0x5c, 0x42, 0xd5, 0xb5, 0x6f, 0xb2, 0xc8, 0x28, 0xde,
-	0x21, 0xd3, 0x46, 0xa9, 0xb3, 0x42, 0xda, 0xa8, 0xb8, 0xa5, 0xe2, 0x1c, 0x1a, 0xf2, 0xef, 0x82,
-	0x33, 0x2d, 0x0c, 0xdd, 0x4f, 0xca, 0xcc, 0x6b, 0xa5, 0xcd, 0x7b, 0x37, 0xe2, 0xc4, 0x3e, 0x6d,
-	0xa8, 0x44, 0x0d, 0x07, 0x7d, 0xa0, 0x08, 0xa5, 0x9b, 0x5a, 0x73, 0x33, 0x7b, 0x31, 0x8a, 0x81,
-	0x6c, 0x2a, 0x89, 0x18, 0x24, 0xdb, 0x51, 0x22, 0x06, 0x6a, 0x0f, 0xc2, 0x39, 0xf4, 0x1c, 0x20,
-	0x6e, 0x1c, 0x68, 0x2b, 0xd1, 0xe5, 0x52, 0xad, 0xa7, 0xd9, 0x9c, 0xb3, 0x1a, 0x45, 0x53, 0x6d,
-	0x05, 0x89, 0x68, 0x66, 0x34, 0x94, 0xe6, 0xd6, 0xdc, 0xf5, 0xa8, 0x36, 0x24, 0x8b, 0x7e, 0xa2,
-	0x36, 0x64, 0xb6, 0x8f, 0x44, 0x6d, 0xc8, 0xe8, 0x18, 0x38, 0x77, 0xf0, 0xf8, 0xaf, 0xef, 0xb7,
-	0xb5, 0x7f, 0xbc, 0xdf, 0xd6, 0xfe, 0xf5, 0x7e, 0x5b, 0xfb, 0xe3, 0xbf, 0xb7, 0x73, 0x80, 0x07,
-	0xa3, 0xdd, 0x01, 0x71, 0x9d, 0x5d, 0xd3, 0x1e, 0x0f, 0xc8, 0x2e, 0xdd, 0xdb, 0x0d, 0x35, 0xb8,
-	0xd3, 0x81, 0x47, 0xdc, 0x0b, 0xe2, 0x7e, 0x9d, 0x9f, 0x9e, 0x9e, 0x2e, 0xf2, 0x7f, 0x72, 0xbe,
-	0xf8, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x51, 0xba, 0xac, 0xe3, 0x19, 0x00, 0x00,
+	// 2884 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x5b, 0x73, 0x1c, 0x47,
+	0xf5, 0xf7, 0xec, 0x45, 0xda, 0x3d, 0xab, 0xcb, 0xaa, 0xa5, 0x48, 0xeb, 0x89, 0xac, 0x28, 0x1d,
+	0xff, 0x1d, 0xe7, 0x26, 0xff, 0x51, 0x08, 0x71, 0x99, 0x5c, 0xb0, 0xe5, 0xb5, 0x2c, 0x88, 0xb5,
+	0xce, 0x68, 0x63, 0x17, 0x29, 0x28, 0x33, 0xda, 0x69, 0x49, 0x1b, 0x8d, 0xa6, 0x97, 0x9e, 0x59,
+	0x29, 0x7a, 0xe0, 0x09, 0x78, 0x02, 0x2a, 0x0f, 0xbc, 0x50, 0xbc, 0x51, 0xa9, 0xe2, 0x03, 0xf0,
+	0xcc, 0x07, 0xe0, 0x01, 0x0a, 0x3e, 0x02, 0x15, 0xaa, 0x78, 0xe2, 0x2b, 0x50, 0x45, 0xf5, 0x6d,
+	0xa6, 0xe7, 0xb6, 0x52, 0x2e, 0x6f, 0x7b, 0x4e, 0xff, 0xfa, 0x74, 0x9f, 0x6b, 0x9f, 0xee, 0x59,
+	0x58, 0x1e, 0x31, 0x1a, 0xd1, 0xf0, 0x16, 0xdd, 0x1c, 0xd0, 0x20, 0x62, 0xd4, 0xdf, 0x10, 0x0c,
+	0xd4, 0x8c, 0x19, 0x78, 0x19, 0x96, 0xba, 0xa7, 0x24, 0x88, 0x9e, 0x3d, 0x22, 0x21, 0x0d, 0x1f,
+	0x12, 0x97, 0x45, 0xfb, 0xc4, 0x8d, 0xf0, 0x3c, 0xcc, 0xee, 0x45, 0x6e, 0x34, 0x0e, 0x1d, 0xf2,
+	0xd3, 0x31, 0x09, 0x23, 0xbc, 0x0f, 0x2d, 0xcd, 0x18, 0xf9, 0xe7, 0x68, 0x09, 0xea, 0x61, 0xe4,
+	0x46, 0xa4, 0x63, 0xad, 0x5b, 0x37, 0x9b, 0x8e, 0x24, 0xd0, 0xbb, 0x30, 0x1b, 0x0a, 0xd0, 0x47,
+	0x23, 0xcf, 0x8d, 0x48, 0xd8, 0xa9, 0xac, 0x57, 0x6f, 0xb6, 0x36, 0x57, 0x36, 0x92, 0x1d, 0xec,
+	0x19, 0xe3, 0x4e, 0x1a, 0x8d, 0xff, 0x66, 0xc1, 0x8c, 0x39, 0x8e, 0xde, 0x84, 0xba, 0x4f, 0x4e,
+	0x89, 0x2f, 0x56, 0x99, 0xdb, 0xbc, 0x56, 0x22, 0x67, 0xe3, 0x03, 0x0e, 0x72, 0x24, 0x16, 0xed,
+	0xc0, 0xdc, 0x49, 0x4a, 0x99, 0x4e, 0x65, 0xdd, 0xba, 0xd9, 0xda, 0x7c, 0xc1, 0x98, 0x5d, 0xa4,
+	0xf3, 0xc3, 0x2b, 0x4e, 0x66, 0x22, 0xfe, 0x36, 0xd4, 0x85, 0x68, 0xd4, 0x84, 0xfa, 0xfd, 0xee,
+	0xbd, 0x8f, 0xb6, 0xdb, 0x57, 0x50, 0x03, 0x6a, 0x3b, 0xbb, 0x0f, 0x7a, 0x6d, 0x0b, 0xb5, 0x60,
+	0xfa, 0xe9, 0x5d, 0x67, 0x77, 0x67, 0x77, 0xbb, 0x5d, 0xe1, 0x88, 0xae, 0xe3, 0xf4, 0x9c, 0x76,
+	0xf5, 0xde, 0x34, 0xd4, 0x85, 0x7c, 0x7c, 0x15, 0x56, 0xb6, 0x49, 0xf4, 0x80, 0xb9, 0x27, 0xe4,
+	0x8c, 0xb2, 0xe3, 0x9d, 0xe0, 0x80, 0x6a, 0x73, 0x7e, 0x6e, 0xc1, 0xf4, 0x13, 0xc2, 0xc2, 0x21,
+	0x0d, 0xb8, 0x2d, 0x4f, 0xdc, 0x4f, 0x28, 0x13, 0x5a, 0xd6, 0x1d, 0x49, 0x08, 0xee, 0x30, 0xa0,
+	0xac, 0x53, 0x51, 0xdc, 0x61, 0x20, 0xb9, 0x23, 0x37, 0x1a, 0x1c, 0x75, 0xaa, 0x92, 0x2b, 0x08,
+	0xce, 0xdd, 0x1f, 0x0f, 0x7d, 0xaf, 0x53, 0x93, 0xde, 0x10, 0x04, 0x5a, 0x87, 0xd6, 0x88, 0x51,
+	0x6f, 0x3c, 0x88, 0x76, 0xdd, 0x13, 0xd2, 0xa9, 0x8b, 0x31, 0x93, 0x85, 0xd6, 0x00, 0x4e, 0xe5,
+	0x26, 0xf6, 0x22, 0xd6, 0x99, 0x12, 0x00, 0x83, 0x83, 0x3f, 0xab, 0xc0, 0x73, 0x79, 0x0d, 0xb8,
+	0xff, 0xd7, 0xa1, 0x75, 0x10, 0x73, 0x3d, 0x15, 0x05, 0x26, 0x0b, 0xbd, 0x0e, 0x0b, 0x24, 0x38,
+	0x1d, 0x32, 0x1a, 0x9c, 0x90, 0x20, 0x0a, 0xb7, 0xe8, 0x38, 0x88, 0x94, 0x2e, 0xf9, 0x01, 0xbe,
+	0x93, 0xc8, 0x0d, 0x8f, 0x15, 0x4c, 0x2a, 0x67, 0x70, 0x92, 0x78, 0xab, 0x99, 0xf1, 0xb6, 0x06,
+	0x70, 0x44, 0x43, 0x2d, 0xbc, 0x2e, 0x67, 0x25, 0x1c, 0x84, 0x61, 0x66, 0x18, 0x84, 0x91, 0x1b,
+	0x0c, 0x88, 0x30, 0x81, 0xd4, 0x30, 0xc5, 0x43, 0xaf, 0xc3, 0xb4, 0xd2, 0xb8, 0x33, 0x2d, 0xe2,
+	0x04, 0x19, 0x71, 0xa2, 0x5c, 0xe4, 0x68, 0x08, 0x7e, 0x05, 0xe6, 0xfb, 0xc4, 0x65, 0x1e, 0x3d,
+	0x0b, 0x94, 0x2b, 0xd1, 0x32, 0x4c, 0x31, 0xe2, 0x86, 0x34, 0x50, 0x56, 0x50, 0x14, 0x4f, 0xa1,
+	0x04, 0x3a, 0xf2, 0xcf, 0x79, 0xae, 0x39, 0xe4, 0x80, 0x91, 0xf0, 0x68, 0x8b, 0x06, 0x07, 0xc3,
+	0x43, 0x1d, 0x0b, 0x3f, 0xb7, 0x60, 0x76, 0x8f, 0x44, 0xd1, 0x30, 0x38, 0xdc, 0x3a, 0x72, 0x83,
+	0x43, 0x82, 0xda, 0x50, 0x3d, 0x26, 0xe7, 0x4a, 0x1e, 0xff, 0x89, 0x6c, 0x68, 0x50, 0xdf, 0x7b,
+	0xe2, 0xfa, 0x63, 0x22, 0x8c, 0xd8, 0x74, 0x62, 0x9a, 0x8f, 0x05, 0xe4, 0x4c, 0x8e, 0x55, 0xe5,
+	0x98, 0xa6, 0xd1, 0x4d, 0x98, 0x67, 0x24, 0x8c, 0x5c, 0x16, 0xf1, 0xd5, 0x86, 0x8c, 0xc8, 0x18,
+	0x69, 0x38, 0x59, 0x36, 0x7e, 0x08, 0x28, 0xb3, 0x3b, 0xee, 0xe7, 0x4d, 0x98, 0x1e, 0x88, 0x3d,
+	0x85, 0x1d, 0x4b, 0xe4, 0x72, 0xc7, 0xcc, 0x41, 0x73, 0xd3, 0x8e, 0x06, 0xe2, 0x0e, 0x2c, 0x6f,
+	0x93, 0xa8, 0x6b, 0xf8, 0x58, 0x6b, 0xfa, 0x29, 0x2c, 0xe5, 0x46, 0x2e, 0x17, 0x4d, 0xef, 0xc1,
+	0x8c, 0x19, 0x34, 0xaa, 0xb0, 0xd8, 0x66, 0x4a, 0x27, 0xc3, 0x22, 0x4c, 0x53, 0x78, 0xfc, 0xcb,
+	0x0a, 0xcc, 0x67, 0x10, 0x68, 0x0e, 0x2a, 0x43, 0xbd, 0x58, 0x65, 0x28, 0xf2, 0x65, 0xc0, 0x88,
+	0x1b, 0x11, 0xef, 0xe9, 0x11, 0x09, 0x94, 0x99, 0x4d, 0x56, 0x12, 0x85, 0x55, 0x33, 0x0a, 0x37,
+	0xa0, 0x2e, 0x22, 0xb5, 0x53, 0xcb, 0x5b, 0xe8, 0x88, 0xb2, 0xa8, 0xef, 0x86, 0x32, 0x73, 0x24,
+	0x8c, 0xfb, 0x8b, 0x51, 0x1a, 0x39, 0xd4, 0xd7, 0x49, 0x19, 0xd3, 0xe8, 0x55, 0x68, 0x0f, 0xc6,
+	0x8c, 0x91, 0x20, 0x72, 0xc6, 0xc1, 0xee, 0xf8, 0x64, 0x9f, 0xc8, 0xbc, 0x9c, 0x75, 0x72, 0x7c,
+	0xb4, 0x09, 0x4b, 0x03, 0xe1, 0xaa, 0x31, 0x73, 0xa3, 0x21, 0x0d, 0xa4, 0x1f, 0x3c, 0x11, 0xc6,
+	0x0d, 0xa7, 0x70, 0x0c, 0xff, 0xd9, 0x82, 0xe7, 0x76, 0xc9, 0x99, 0x61, 0x0a, 0x1d, 0xc6, 0xaf,
+	0x42, 0x9b, 0xdb, 0xfa, 0xc0, 0xa7, 0x67, 0x7d, 0x72, 0x32, 0xf2, 0x93, 0xe2, 0x9e, 0xe3, 0xa3,
+	0xf7, 0xa0, 0x76, 0xea, 0x32, 0xed, 0x85, 0x57, 0x0d, 0x85, 0x0b, 0x65, 0x6f, 0x3c, 0x71, 0x59,
+	0xd8, 0x0d, 0x22, 0x76, 0xee, 0x88, 0x79, 0xf6, 0xdb, 0xd0, 0x8c, 0x59, 0x05, 0xc1, 0xbe, 0x04,
+	0xf5, 0x53, 0x23, 0xd2, 0x25, 0x71, 0xa7, 0x72, 0xdb, 0xc2, 0x7b, 0xb0, 0x98, 0x5d, 0x81, 0xc7,
+	0xcf, 0x3b, 0xd0, 0x32, 0xbc, 0x2d, 0x44, 0x4d, 0x0e, 0x0e, 0x13, 0x8e, 0x5f, 0x16, 0x45, 0xae,
+	0xc0, 0x24, 0x99, 0x00, 0xc1, 0xbf, 0xb0, 0x60, 0x31, 0x8b, 0xfc, 0xda, 0xcb, 0xa3, 0x5b, 0xd0,
+	0xd0, 0x06, 0x56, 0x27, 0xd5, 0xa2, 0x31, 0x95, 0x47, 0x85, 0x98, 0x13, 0x83, 0xf0, 0x2d, 0xb8,
+	0x96, 0xde, 0x45, 0x9f, 0x8e, 0xa8, 0x4f, 0x0f, 0xcf, 0xcb, 0xf6, 0xfd, 0x47, 0x0b, 0x66, 0x34,
+	0x66, 0x97, 0x7a, 0x84, 0x97, 0x2c, 0x1e, 0x8a, 0x71, 0xaa, 0x29, 0x0a, 0x21, 0xa8, 0x05, 0xbc,
+	0x4e, 0x4a, 0xbb, 0x8b, 0xdf, 0x32, 0x5a, 0x7d, 0xf2, 0xd8, 0x8d, 0x8e, 0x74, 0x75, 0xd1, 0x34,
+	0x1f, 0xe3, 0xd5, 0x56, 0xcc, 0x91, 0x85, 0x39, 0xa6, 0xd1, 0x2a, 0x34, 0x07, 0xbe, 0x1b, 0x86,
+	0xc6, 0xd9, 0x93, 0x30, 0x92, 0x4c, 0x9a, 0x32, 0x32, 0x09, 0xff, 0xc9, 0xd8, 0x68, 0xd7, 0x3b,
+	0x24, 0xbc, 0x80, 0x87, 0x74, 0xcc, 0x06, 0xa4, 0x6f, 0x6e, 0x37, 0xc5, 0xe3, 0x98, 0xc8, 0x65,
+	0x87, 0x24, 0x52, 0x18, 0xb9, 0xf9, 0x14, 0x2f, 0x56, 0xac, 0x6a, 0x28, 0x86, 0xa0, 0x16, 0x9d,
+	0x8f, 0xf4, 0xc6, 0xc5, 0x6f, 0x69, 0x18, 0x3e, 0x4f, 0xed, 0x58, 0x51, 0x5c, 0x51, 0x12, 0x78,
+	0x23, 0x3a, 0x0c, 0x22, 0xb5, 0xe3, 0x98, 0xc6, 0x7f, 0xb0, 0xe0, 0xf9, 0x32, 0x7f, 0xf0, 0xe8,
+	0xb8, 0x0e, 0xb3, 0x86, 0xbb, 0x63, 0x25, 0xd2, 0x4c, 0xf4, 0x06, 0xd4, 0x03, 0xea, 0x15, 0xb6,
+	0x4c, 0xa6, 0xeb, 0x1c, 0x89, 0xe2, 0x70, 0xe2, 0xf1, 0xaa, 0x5c, 0x2d, 0x85, 0x73, 0x03, 0x3a,
+	0x12, 0x85, 0xff, 0x6e, 0xc1, 0xd5, 0x2d, 0x39, 0x7e, 0x71, 0x9c, 0xa3, 0xf7, 0x95, 0x65, 0x2a,
+	0xa2, 0xeb, 0x7a, 0xcd, 0x90, 0x5d, 0x2a, 0x63, 0xa3, 0x37, 0xe2, 0x53, 0xa4, 0x19, 0xb1, 0x0b,
+	0x53, 0x92, 0xe6, 0xdd, 0xd2, 0x6e, 0xaf, 0xf7, 0xb8, 0x7d, 0x05, 0x21, 0x98, 0xdb, 0xeb, 0xdf,
+	0x75, 0xfa, 0xcf, 0xee, 0x6e, 0xf5, 0x77, 0x9e, 0xec, 0xf4, 0x7f, 0xd8, 0xb6, 0xd0, 0x02, 0xcc,
+	0xee, 0xf5, 0x7b, 0x8f, 0x13, 0x56, 0x05, 0xcd, 0x42, 0x73, 0xab, 0xb7, 0xfb, 0x60, 0x67, 0xfb,
+	0x23, 0xa7, 0xdb, 0xae, 0xf2, 0xb6, 0xca, 0xe9, 0xee, 0x75, 0xfb, 0xed, 0x1a, 0x9a, 0x81, 0xc6,
+	0x76, 0xef, 0x99, 0x6c, 0xb2, 0xea, 0xf8, 0x18, 0x56, 0x8a, 0x36, 0xc3, 0x0d, 0x9e, 0x55, 0x27,
+	0x8e, 0xb5, 0x8a, 0x59, 0xb5, 0x8b, 0x2a, 0x6d, 0xb5, 0xb8, 0xd2, 0xe2, 0xdf, 0x5a, 0xd0, 0x79,
+	0x44, 0xbd, 0xe1, 0xc1, 0xf9, 0xa5, 0xac, 0x07, 0x74, 0x44, 0x64, 0xd9, 0xd5, 0xee, 0x7c, 0xa1,
+	0xb8, 0x18, 0xf4, 0x34, 0xce, 0x31, 0xa6, 0xa0, 0x1b, 0x30, 0xc7, 0x88, 0xae, 0xde, 0xe4, 0xae,
+	0xef, 0x8b, 0x7d, 0x35, 0x9c, 0x0c, 0x97, 0x07, 0xde, 0x52, 0x91, 0x30, 0x74, 0x47, 0xf9, 0x4f,
+	0x76, 0xcd, 0x37, 0x2e, 0x58, 0x3b, 0xe5, 0x3a, 0x9d, 0xee, 0xbb, 0x49, 0x19, 0x88, 0x69, 0xfc,
+	0xad, 0x02, 0xb7, 0xce, 0x43, 0xcb, 0xe9, 0x3e, 0xea, 0x3d, 0xe9, 0x3e, 0x73, 0x7a, 0x1f, 0x70,
+	0x8f, 0xcd, 0x40, 0xe3, 0xee, 0xfd, 0xfb, 0x92, 0xaa, 0xe1, 0x5f, 0x59, 0xb0, 0x5c, 0x60, 0x39,
+	0xee, 0xa6, 0x1f, 0x40, 0xfb, 0xc0, 0x1d, 0xfa, 0xc4, 0xeb, 0x25, 0xd6, 0xb2, 0x2e, 0x67, 0xad,
+	0xdc, 0x44, 0xe5, 0x84, 0x4a, 0xde, 0xe7, 0xe6, 0x49, 0x8d, 0x77, 0xe0, 0xea, 0x7d, 0x12, 0x46,
+	0x8c, 0x5e, 0xc6, 0x8f, 0xab, 0xd0, 0x3c, 0x26, 0x64, 0xd4, 0x17, 0x47, 0x7b, 0x45, 0x78, 0x20,
+	0x61, 0x60, 0x02, 0x2b, 0x45, 0xa2, 0xb8, 0x62, 0xdf, 0x87, 0x85, 0x81, 0x4f, 0xdc, 0x60, 0x2c,
+	0xa1, 0x82, 0xa9, 0x0e, 0x85, 0x55, 0x33, 0x97, 0xb2, 0x18, 0x27, 0x3f, 0x0d, 0xff, 0x9b, 0xf7,
+	0x86, 0x66, 0x13, 0x11, 0x97, 0x32, 0xcb, 0x28, 0x65, 0xcb, 0x30, 0xe5, 0xd3, 0xc1, 0x31, 0xf1,
+	0xd4, 0x3e, 0x15, 0x65, 0xd4, 0xf9, 0x6a, 0xaa, 0xce, 0x2f, 0xc3, 0x94, 0xbc, 0x79, 0xa9, 0xe2,
+	0xa7, 0xa8, 0xc4, 0x6a, 0x75, 0x33, 0x53, 0x52, 0x95, 0x7c, 0x2a, 0x5b, 0xc9, 0xbb, 0x30, 0xe7,
+	0x91, 0x91, 0x4f, 0xcf, 0xf5, 0xe9, 0xa6, 0xda, 0x68, 0xf3, 0xb2, 0xc6, 0x37, 0x7f, 0x3f, 0x05,
+	0x72, 0x32, 0x93, 0xf8, 0xd9, 0x8a, 0xf2, 0xb0, 0xd4, 0x09, 0x63, 0x65, 0x4e, 0x98, 0x0e, 0x4c,
+	0xbb, 0x87, 0xb2, 0xa4, 0x4a, 0xc7, 0x6b, 0x92, 0x8f, 0xd0, 0x83, 0x03, 0xc2, 0x62, 0xc5, 0x35,
+	0xc9, 0x6f, 0x0c, 0xe4, 0x53, 0x32, 0x18, 0x47, 0x94, 0x0f, 0x4a, 0xed, 0x0d, 0x0e, 0x5e, 0x80,
+	0xf9, 0x6d, 0x79, 0x6a, 0xc4, 0x4d, 0xeb, 0xfb, 0x30, 0x9b, 0xb0, 0xb8, 0x7f, 0xe3, 0x7e, 0xcf,
+	0xba, 0x54, 0xbf, 0x87, 0x6f, 0xc2, 0x9c, 0x12, 0x60, 0x5c, 0x19, 0x8a, 0xce, 0x5f, 0xfc, 0x36,
+	0xcc, 0xc4, 0x48, 0xbe, 0xd2, 0xcb, 0x50, 0xe3, 0x23, 0x1d, 0x2b, 0xd7, 0x16, 0xc4, 0x6b, 0x08,
+	0x00, 0xee, 0xc2, 0x2c, 0xe7, 0x6c, 0x71, 0xaf, 0x94, 0x46, 0x09, 0xef, 0x6f, 0xe5, 0xf4, 0x47,
+	0xd4, 0x23, 0x71, 0x7f, 0x9b, 0xb0, 0xf0, 0xcf, 0xa0, 0xb5, 0x45, 0x4f, 0x4e, 0xdc, 0xc0, 0x13,
+	0x42, 0xda, 0x50, 0x25, 0xc1, 0xa9, 0x50, 0xb3, 0xe9, 0xf0, 0x9f, 0x22, 0x40, 0x8e, 0x88, 0xef,
+	0xab, 0x38, 0x93, 0x44, 0xd2, 0xaf, 0x55, 0x8d, 0x7e, 0x8d, 0x87, 0x8d, 0xcb, 0x0e, 0xc7, 0xb2,
+	0x5f, 0xaf, 0x09, 0x19, 0x09, 0x83, 0x6f, 0x70, 0x1c, 0x12, 0xa6, 0x22, 0x4d, 0xfc, 0xc6, 0x8f,
+	0xa0, 0xc5, 0xfb, 0xd4, 0x80, 0xf8, 0xa5, 0x3a, 0x20, 0xe3, 0x68, 0xca, 0x1f, 0xda, 0x55, 0xf3,
+	0xd0, 0xc6, 0xff, 0xa9, 0x40, 0x23, 0x4e, 0x9b, 0xef, 0x40, 0x33, 0xe4, 0xce, 0xe1, 0x84, 0xb2,
+	0x67, 0xb9, 0xe3, 0x12, 0x28, 0x9f, 0x37, 0xd0, 0x56, 0xed, 0x54, 0x72, 0xf3, 0x52, 0x56, 0x77,
+	0x12, 0x28, 0xfa, 0x1e, 0xcc, 0x0f, 0x83, 0x7d, 0x3a, 0x0e, 0x3c, 0xa5, 0x92, 0x3e, 0xaa, 0x97,
+	0xcd, 0x12, 0x90, 0x68, 0xeb, 0x64, 0xe1, 0xe8, 0x1e, 0xb4, 0xe9, 0x38, 0x4a, 0x8b, 0xa8, 0x4d,
+	0x14, 0x91, 0xc3, 0xa3, 0xdb, 0xdc, 0xe5, 0xb1, 0x43, 0x85, 0xb1, 0x33, 0xd3, 0x93, 0x51, 0xc7,
+	0x84, 0xf2, 0xc4, 0xe3, 0x91, 0x25, 0xda, 0x3e, 0xd5, 0xf1, 0x68, 0x9a, 0xfb, 0x9b, 0x04, 0xa7,
+	0x3b, 0xf2, 0xa6, 0xd1, 0x74, 0x24, 0x81, 0x6f, 0xc1, 0x62, 0xba, 0xa4, 0xc9, 0x58, 0xef, 0xc0,
+	0xb4, 0x8c, 0xee, 0x50, 0x05, 0x92, 0x26, 0xf1, 0x6f, 0x2c, 0x58, 0xc8, 0x15, 0x41, 0x74, 0x07,
+	0x5a, 0xc7, 0x43, 0xdf, 0x27, 0x5e, 0xff, 0x52, 0x39, 0x66, 0x82, 0xd1, 0x3b, 0x30, 0xc3, 0xc6,
+	0x41, 0x30, 0x0c, 0x0e, 0x75, 0xd5, 0x9e, 0x3c, 0x39, 0x85, 0xc6, 0x5b, 0x22, 0xf7, 0x79, 0xc3,
+	0x1d, 0x6f, 0x3e, 0xd6, 0xd4, 0x32, 0x34, 0xe5, 0xb6, 0x19, 0xb9, 0xd1, 0xd1, 0xde, 0x88, 0x0c,
+	0xf4, 0x19, 0xa9, 0x69, 0xfe, 0x64, 0xd2, 0xd0, 0x3d, 0x7b, 0x59, 0xad, 0x56, 0xb5, 0xb7, 0x52,
+	0x5c, 0x7b, 0x53, 0x77, 0x4b, 0x1b, 0x1a, 0x07, 0x63, 0xdf, 0x17, 0x6e, 0x50, 0x1d, 0xb6, 0xa6,
+	0x4d, 0xcb, 0xd6, 0x53, 0x96, 0x45, 0xaf, 0x40, 0x9d, 0x1f, 0xda, 0x61, 0x67, 0x6a, 0xbd, 0x9a,
+	0x29, 0x1c, 0xf1, 0x7d, 0x42, 0x22, 0xf8, 0x76, 0x46, 0x6c, 0x1c, 0xc4, 0xd7, 0x46, 0x45, 0xc9,
+	0xc7, 0xa3, 0x71, 0x40, 0x1c, 0xf9, 0xb4, 0xd1, 0xd0, 0x8f, 0x47, 0x31, 0x0b, 0xdd, 0x50, 0x97,
+	0xc0, 0xe6, 0x7a, 0x35, 0xfb, 0x6a, 0xe2, 0x32, 0x59, 0x9b, 0xf8, 0x38, 0xde, 0x81, 0x69, 0xc5,
+	0xb8, 0xec, 0x55, 0x4f, 0xd8, 0x48, 0xb4, 0xf8, 0x3a, 0xa3, 0x25, 0x85, 0xef, 0x88, 0x52, 0xac,
+	0x3c, 0xc4, 0x83, 0x25, 0x56, 0xd4, 0xba, 0x48, 0x51, 0x7c, 0x4d, 0x74, 0xe9, 0x4f, 0x33, 0x57,
+	0xd9, 0xb8, 0xca, 0x3f, 0x80, 0xa5, 0xec, 0x98, 0x76, 0x21, 0x23, 0x23, 0xaa, 0x5d, 0xc8, 0x7f,
+	0x8b, 0xdc, 0x50, 0x18, 0xed, 0x7f, 0x4d, 0xe3, 0x4f, 0xe0, 0x6a, 0xf1, 0x32, 0x7c, 0xbb, 0x8f,
+	0x60, 0x21, 0x7b, 0x97, 0x2e, 0xea, 0x79, 0x8a, 0x36, 0xe2, 0xe4, 0x67, 0xe2, 0xbf, 0x5a, 0x70,
+	0xcd, 0x21, 0x81, 0x47, 0x58, 0x76, 0xc6, 0x57, 0xb9, 0xd4, 0x3f, 0x48, 0x5d, 0xea, 0x37, 0x4d,
+	0x53, 0x4e, 0x5a, 0xe3, 0x9b, 0xbb, 0xdc, 0x7f, 0x08, 0xf3, 0x72, 0x25, 0xa2, 0x0b, 0xd8, 0xd7,
+	0x3e, 0x02, 0xfe, 0x5b, 0x81, 0x19, 0x2d, 0x53, 0xbc, 0xaf, 0x14, 0x09, 0x34, 0x73, 0xac, 0x92,
+	0xc9, 0xb1, 0x55, 0x68, 0x46, 0xba, 0xc4, 0x2b, 0xd9, 0x09, 0x03, 0xbd, 0xa5, 0x4c, 0x26, 0xcb,
+	0xf2, 0x8b, 0x39, 0x93, 0xc9, 0x45, 0xb3, 0x16, 0x42, 0x0f, 0x0a, 0x2a, 0x7b, 0x3d, 0xf7, 0xa0,
+	0x95, 0xb1, 0x45, 0x41, 0x75, 0x7f, 0x23, 0x9d, 0xe6, 0x2b, 0x25, 0xeb, 0x7f, 0xed, 0x54, 0xff,
+	0xea, 0x2e, 0xfd, 0xbd, 0x05, 0xcf, 0x97, 0x45, 0x0f, 0x4f, 0x08, 0x23, 0x3e, 0x1d, 0x72, 0x3a,
+	0x14, 0xaf, 0xb0, 0x99, 0xf8, 0xd4, 0x7c, 0xf4, 0x66, 0xee, 0x9d, 0xa4, 0x54, 0xe1, 0x18, 0x28,
+	0x4a, 0x35, 0xa3, 0xfb, 0x3e, 0x39, 0x91, 0xe7, 0x6f, 0xd3, 0x89, 0x69, 0x8c, 0xa0, 0xfd, 0xc1,
+	0x30, 0xe4, 0x4d, 0x3b, 0x8d, 0xcb, 0xc0, 0x6d, 0x68, 0x70, 0xba, 0xb4, 0x7a, 0x77, 0x60, 0xda,
+	0x23, 0x07, 0xee, 0xd8, 0x8f, 0x54, 0x0b, 0xa4, 0x49, 0xfc, 0x5d, 0x98, 0x33, 0xa4, 0xe9, 0xe2,
+	0xc4, 0xa9, 0xa2, 0xe2, 0xa4, 0xd6, 0x70, 0x24, 0x02, 0x5f, 0x87, 0xb9, 0xbb, 0x9e, 0xc7, 0xb9,
+	0x3a, 0x73, 0x0b, 0x16, 0xc7, 0x3f, 0x82, 0x99, 0x18, 0xa5, 0x9e, 0x4d, 0x09, 0x63, 0x94, 0xed,
+	0x45, 0x6c, 0x18, 0x1c, 0x2a, 0xa8, 0xc9, 0xe2, 0xf6, 0x3d, 0x75, 0xfd, 0xa1, 0x27, 0x6e, 0x49,
+	0x5d, 0x3e, 0x20, 0xf3, 0xbb, 0xe9, 0xe4, 0xf8, 0xf8, 0x15, 0x58, 0x70, 0xc8, 0x09, 0x3d, 0x25,
+	0xe6, 0x36, 0x96, 0xa0, 0x3e, 0x0c, 0x3c, 0xf2, 0xa9, 0xfe, 0x36, 0x21, 0x08, 0xbc, 0x03, 0xf3,
+	0x26, 0x54, 0x5d, 0xba, 0xa9, 0x6c, 0x54, 0x1b, 0x4e, 0x85, 0x1e, 0xf3, 0x4b, 0x6c, 0x40, 0xce,
+	0xee, 0x4b, 0xe3, 0x70, 0x98, 0x0a, 0x8e, 0x0c, 0x17, 0xbf, 0x06, 0x8b, 0xea, 0xd9, 0xd9, 0xf4,
+	0x43, 0xc9, 0xba, 0x2e, 0x2c, 0xa4, 0xc1, 0xdf, 0xbc, 0x15, 0xde, 0x80, 0xe7, 0xf6, 0x48, 0x64,
+	0xec, 0x70, 0xf2, 0x8e, 0xde, 0x86, 0xc5, 0x2c, 0xfc, 0x52, 0x7b, 0xc2, 0x77, 0xc0, 0xe6, 0x47,
+	0xd9, 0x38, 0xd8, 0x32, 0x9f, 0x69, 0xf5, 0x62, 0xab, 0xd0, 0x64, 0xf1, 0xab, 0x84, 0x25, 0x5e,
+	0x25, 0x12, 0x06, 0xfe, 0x75, 0x05, 0x96, 0x9c, 0x71, 0x20, 0x7a, 0x4f, 0x73, 0xf6, 0x97, 0x7a,
+	0xd7, 0x4b, 0xdd, 0xea, 0xaa, 0xd9, 0x5b, 0xdd, 0xa4, 0x97, 0xbd, 0x1e, 0xc0, 0x88, 0xd1, 0x11,
+	0x61, 0xd1, 0x90, 0xe8, 0xc2, 0x75, 0xcb, 0x0c, 0xee, 0x82, 0xad, 0x6d, 0x3c, 0x8e, 0x67, 0xc8,
+	0x4a, 0x68, 0x88, 0xb0, 0xdf, 0x85, 0xf9, 0xcc, 0xf0, 0x97, 0x2a, 0x32, 0x9f, 0x55, 0xa0, 0x53,
+	0x68, 0x4b, 0xee, 0x89, 0x89, 0x96, 0xcc, 0xbf, 0xcd, 0x55, 0x8a, 0xde, 0xe6, 0x8a, 0x4e, 0xd1,
+	0x6a, 0xc9, 0x29, 0x5a, 0x54, 0xd1, 0x6a, 0x25, 0x15, 0x6d, 0x1d, 0x5a, 0xe2, 0x1b, 0x8c, 0xfa,
+	0xe0, 0xa0, 0x3e, 0xd0, 0x19, 0x2c, 0xf4, 0x96, 0xbe, 0x6a, 0x4e, 0xe5, 0x9a, 0x84, 0x22, 0x2b,
+	0xab, 0x1b, 0xe7, 0xe6, 0xe7, 0xf3, 0x30, 0xad, 0x5e, 0xc7, 0xd0, 0x16, 0xb4, 0xfa, 0xcc, 0x1d,
+	0x1c, 0xcb, 0x0f, 0xa6, 0xa8, 0x93, 0xfb, 0x86, 0xaa, 0x62, 0xce, 0x5e, 0x2e, 0x18, 0xe1, 0x4f,
+	0x10, 0x57, 0xfe, 0xdf, 0x42, 0x1f, 0x43, 0x3b, 0xfb, 0x1d, 0x10, 0x61, 0x03, 0x5f, 0xf2, 0x99,
+	0xd3, 0x5e, 0x9f, 0x88, 0x11, 0xd2, 0xd1, 0x3d, 0x68, 0xe8, 0xef, 0x64, 0xc8, 0x3c, 0xff, 0x32,
+	0xdf, 0xd9, 0xec, 0x4e, 0xe1, 0x98, 0x94, 0xf1, 0x21, 0xcc, 0xa6, 0x3e, 0x5e, 0xa1, 0x94, 0xa5,
+	0x0a, 0x3e, 0xba, 0xd9, 0xd7, 0xca, 0x01, 0x52, 0xe4, 0x53, 0x71, 0x1b, 0x30, 0xbf, 0x55, 0xa1,
+	0x17, 0xd3, 0xda, 0x14, 0x7c, 0xe1, 0xb2, 0x5f, 0x98, 0x04, 0x91, 0x82, 0xfb, 0x30, 0x97, 0xfe,
+	0x86, 0x81, 0xd6, 0x2f, 0xfa, 0x80, 0x62, 0xaf, 0x4d, 0x40, 0xc4, 0x52, 0xd3, 0xeb, 0xa1, 0xf5,
+	0xd2, 0xad, 0x14, 0x49, 0x2d, 0xf8, 0xae, 0x81, 0xaf, 0x20, 0x3f, 0xfb, 0x29, 0x4f, 0x3f, 0x2e,
+	0xa3, 0x9b, 0xa5, 0x73, 0x33, 0x5f, 0x23, 0xec, 0x1b, 0x97, 0x40, 0xca, 0xd5, 0x7e, 0x02, 0x28,
+	0xff, 0xa6, 0x8b, 0xae, 0x5f, 0xe6, 0xfd, 0xd9, 0xc6, 0x17, 0xa0, 0xe4, 0x0a, 0x3f, 0x86, 0x85,
+	0xdc, 0x6b, 0x24, 0x7a, 0xc9, 0x98, 0x5a, 0xf6, 0xca, 0x6b, 0xbf, 0x38, 0x19, 0x14, 0x2b, 0x90,
+	0x7f, 0x14, 0x4c, 0x29, 0x50, 0xfa, 0xfc, 0x68, 0xe3, 0x0b, 0x50, 0x71, 0xb2, 0xe8, 0xc7, 0xa8,
+	0x54, 0xb2, 0x64, 0x1e, 0xad, 0xec, 0x4e, 0xe1, 0x98, 0x94, 0xf1, 0x3e, 0x4c, 0x2b, 0x16, 0xba,
+	0x9a, 0x87, 0x69, 0x09, 0x2b, 0x45, 0x43, 0x52, 0xc0, 0x2e, 0xcc, 0x98, 0xf7, 0x76, 0xb4, 0x56,
+	0xfa, 0xaa, 0x29, 0x45, 0x4d, 0x7c, 0xf5, 0x8c, 0x95, 0x12, 0xd7, 0xba, 0xac, 0x52, 0xe6, 0x6d,
+	0xdc, 0xee, 0x14, 0x8e, 0x49, 0x19, 0x07, 0xe2, 0xd3, 0x72, 0xee, 0xde, 0x85, 0x32, 0xd1, 0x57,
+	0x76, 0xff, 0xb3, 0xaf, 0x5f, 0x88, 0x8b, 0x33, 0xa2, 0xb8, 0xa1, 0x4d, 0x65, 0xc4, 0xc4, 0x1b,
+	0x93, 0x7d, 0xe3, 0x12, 0x48, 0xb9, 0x5a, 0x17, 0x9a, 0x71, 0x53, 0x89, 0x9e, 0x37, 0xa6, 0x65,
+	0x1b, 0x57, 0xfb, 0x6a, 0xf1, 0x60, 0xec, 0x71, 0xd5, 0x38, 0xa6, 0x3c, 0x9e, 0x6e, 0x39, 0xed,
+	0x95, 0xa2, 0x21, 0x29, 0xe0, 0x21, 0x40, 0xd2, 0xf0, 0xa1, 0xd5, 0xd4, 0xfe, 0x33, 0x2d, 0xa3,
+	0x6d, 0x97, 0x8c, 0xc6, 0xb1, 0x63, 0xb6, 0x70, 0xa9, 0xd8, 0x29, 0x68, 0x04, 0xed, 0xd5, 0xd2,
+	0xf1, 0xb8, 0xee, 0xa5, 0x1b, 0xb0, 0x54, 0xdd, 0x2b, 0x6c, 0xe5, 0xec, 0xb5, 0x09, 0x08, 0x29,
+	0x75, 0x00, 0x8b, 0x05, 0x1d, 0x05, 0xfa, 0xbf, 0x4c, 0x00, 0x16, 0x77, 0x6f, 0xf6, 0x4b, 0x17,
+	0xc1, 0xc4, 0x22, 0xf7, 0x6e, 0xff, 0xe5, 0x8b, 0x35, 0xeb, 0x1f, 0x5f, 0xac, 0x59, 0xff, 0xfc,
+	0x62, 0xcd, 0xfa, 0xdd, 0xbf, 0xd6, 0xae, 0x00, 0x1e, 0x1c, 0x6d, 0x0c, 0x08, 0x0b, 0x36, 0x5c,
+	0x7f, 0x38, 0x20, 0x1b, 0x74, 0x73, 0x43, 0x8b, 0x61, 0xa3, 0x41, 0x48, 0xd8, 0x29, 0x61, 0x1f,
+	0x57, 0x46, 0xfb, 0xfb, 0x53, 0xe2, 0x7f, 0x5c, 0x6f, 0xfe, 0x6f, 0x00, 0xbb, 0xd7, 0x7a, 0x0b,
+	0xe1, 0x25, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3248,9 +4375,11 @@ type ControlClient interface {
 	TrackStatus(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (Control_TrackStatusClient, error)
 	GetFrameworkInfo(ctx context.Context, in *GetFrameworkInfoRequest, opts ...grpc.CallOption) (*GetFrameworkInfoReply, error)
 	Teardown(ctx context.Context, in *TeardownRequest, opts ...grpc.CallOption) (*TeardownReply, error)
+	RefreshConfig(ctx context.Context, in *RefreshConfigRequest, opts ...grpc.CallOption) (*RefreshConfigReply, error)
 	GetEnvironments(ctx context.Context, in *GetEnvironmentsRequest, opts ...grpc.CallOption) (*GetEnvironmentsReply, error)
 	NewEnvironment(ctx context.Context, in *NewEnvironmentRequest, opts ...grpc.CallOption) (*NewEnvironmentReply, error)
 	GetEnvironment(ctx context.Context, in *GetEnvironmentRequest, opts ...grpc.CallOption) (*GetEnvironmentReply, error)
+	GetEnvironmentTopology(ctx context.Context, in *GetEnvironmentTopologyRequest, opts ...grpc.CallOption) (*GetEnvironmentTopologyReply, error)
 	ControlEnvironment(ctx context.Context, in *ControlEnvironmentRequest, opts ...grpc.CallOption) (*ControlEnvironmentReply, error)
 	ModifyEnvironment(ctx context.Context, in *ModifyEnvironmentRequest, opts ...grpc.CallOption) (*ModifyEnvironmentReply, error)
 	DestroyEnvironment(ctx context.Context, in *DestroyEnvironmentRequest, opts ...grpc.CallOption) (*DestroyEnvironmentReply, error)
@@ -3259,11 +4388,13 @@ type ControlClient interface {
 	CleanupTasks(ctx context.Context, in *CleanupTasksRequest, opts ...grpc.CallOption) (*CleanupTasksReply, error)
 	GetRoles(ctx context.Context, in *GetRolesRequest, opts ...grpc.CallOption) (*GetRolesReply, error)
 	GetWorkflowTemplates(ctx context.Context, in *GetWorkflowTemplatesRequest, opts ...grpc.CallOption) (*GetWorkflowTemplatesReply, error)
+	RenderWorkflowTemplate(ctx context.Context, in *RenderWorkflowTemplateRequest, opts ...grpc.CallOption) (*RenderWorkflowTemplateReply, error)
 	ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposReply, error)
 	AddRepo(ctx context.Context, in *AddRepoRequest, opts ...grpc.CallOption) (*AddRepoReply, error)
 	RemoveRepo(ctx context.Context, in *RemoveRepoRequest, opts ...grpc.CallOption) (*RemoveRepoReply, error)
 	RefreshRepos(ctx context.Context, in *RefreshReposRequest, opts ...grpc.CallOption) (*RefreshReposReply, error)
 	SetDefaultRepo(ctx context.Context, in *SetDefaultRepoRequest, opts ...grpc.CallOption) (*SetDefaultRepoReply, error)
+	GetRunConfiguration(ctx context.Context, in *GetRunConfigurationRequest, opts ...grpc.CallOption) (*GetRunConfigurationReply, error)
 }
 
 type controlClient struct {
@@ -3324,6 +4455,15 @@ func (c *controlClient) Teardown(ctx context.Context, in *TeardownRequest, opts
 	return out, nil
 }
 
+func (c *controlClient) RefreshConfig(ctx context.Context, in *RefreshConfigRequest, opts ...grpc.CallOption) (*RefreshConfigReply, error) {
+	out := new(RefreshConfigReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/RefreshConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) GetEnvironments(ctx context.Context, in *GetEnvironmentsRequest, opts ...grpc.CallOption) (*GetEnvironmentsReply, error) {
 	out := new(GetEnvironmentsReply)
 	err := c.cc.Invoke(ctx, "/o2control.Control/GetEnvironments", in, out, opts...)
@@ -3351,6 +4491,15 @@ func (c *controlClient) GetEnvironment(ctx context.Context, in *GetEnvironmentRe
 	return out, nil
 }
 
+func (c *controlClient) GetEnvironmentTopology(ctx context.Context, in *GetEnvironmentTopologyRequest, opts ...grpc.CallOption) (*GetEnvironmentTopologyReply, error) {
+	out := new(GetEnvironmentTopologyReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetEnvironmentTopology", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) ControlEnvironment(ctx context.Context, in *ControlEnvironmentRequest, opts ...grpc.CallOption) (*ControlEnvironmentReply, error) {
 	out := new(ControlEnvironmentReply)
 	err := c.cc.Invoke(ctx, "/o2control.Control/ControlEnvironment", in, out, opts...)
@@ -3423,6 +4572,15 @@ func (c *controlClient) GetWorkflowTemplates(ctx context.Context, in *GetWorkflo
 	return out, nil
 }
 
+func (c *controlClient) RenderWorkflowTemplate(ctx context.Context, in *RenderWorkflowTemplateRequest, opts ...grpc.CallOption) (*RenderWorkflowTemplateReply, error) {
+	out := new(RenderWorkflowTemplateReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/RenderWorkflowTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlClient) ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposReply, error) {
 	out := new(ListReposReply)
 	err := c.cc.Invoke(ctx, "/o2control.Control/ListRepos", in, out, opts...)
@@ -3468,14 +4626,25 @@ func (c *controlClient) SetDefaultRepo(ctx context.Context, in *SetDefaultRepoRe
 	return out, nil
 }
 
+func (c *controlClient) GetRunConfiguration(ctx context.Context, in *GetRunConfigurationRequest, opts ...grpc.CallOption) (*GetRunConfigurationReply, error) {
+	out := new(GetRunConfigurationReply)
+	err := c.cc.Invoke(ctx, "/o2control.Control/GetRunConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ControlServer is the server API for Control service.
 type ControlServer interface {
 	TrackStatus(*StatusRequest, Control_TrackStatusServer) error
 	GetFrameworkInfo(context.Context, *GetFrameworkInfoRequest) (*GetFrameworkInfoReply, error)
 	Teardown(context.Context, *TeardownRequest) (*TeardownReply, error)
+	RefreshConfig(context.Context, *RefreshConfigRequest) (*RefreshConfigReply, error)
 	GetEnvironments(context.Context, *GetEnvironmentsRequest) (*GetEnvironmentsReply, error)
 	NewEnvironment(context.Context, *NewEnvironmentRequest) (*NewEnvironmentReply, error)
 	GetEnvironment(context.Context, *GetEnvironmentRequest) (*GetEnvironmentReply, error)
+	GetEnvironmentTopology(context.Context, *GetEnvironmentTopologyRequest) (*GetEnvironmentTopologyReply, error)
 	ControlEnvironment(context.Context, *ControlEnvironmentRequest) (*ControlEnvironmentReply, error)
 	ModifyEnvironment(context.Context, *ModifyEnvironmentRequest) (*ModifyEnvironmentReply, error)
 	DestroyEnvironment(context.Context, *DestroyEnvironmentRequest) (*DestroyEnvironmentReply, error)
@@ -3484,11 +4653,13 @@ type ControlServer interface {
 	CleanupTasks(context.Context, *CleanupTasksRequest) (*CleanupTasksReply, error)
 	GetRoles(context.Context, *GetRolesRequest) (*GetRolesReply, error)
 	GetWorkflowTemplates(context.Context, *GetWorkflowTemplatesRequest) (*GetWorkflowTemplatesReply, error)
+	RenderWorkflowTemplate(context.Context, *RenderWorkflowTemplateRequest) (*RenderWorkflowTemplateReply, error)
 	ListRepos(context.Context, *ListReposRequest) (*ListReposReply, error)
 	AddRepo(context.Context, *AddRepoRequest) (*AddRepoReply, error)
 	RemoveRepo(context.Context, *RemoveRepoRequest) (*RemoveRepoReply, error)
 	RefreshRepos(context.Context, *RefreshReposRequest) (*RefreshReposReply, error)
 	SetDefaultRepo(context.Context, *SetDefaultRepoRequest) (*SetDefaultRepoReply, error)
+	GetRunConfiguration(context.Context, *GetRunConfigurationRequest) (*GetRunConfigurationReply, error)
 }
 
 // UnimplementedControlServer can be embedded to have forward compatible implementations.
@@ -3504,6 +4675,9 @@ func (*UnimplementedControlServer) GetFrameworkInfo(ctx context.Context, req *Ge
 func (*UnimplementedControlServer) Teardown(ctx context.Context, req *TeardownRequest) (*TeardownReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Teardown not implemented")
 }
+func (*UnimplementedControlServer) RefreshConfig(ctx context.Context, req *RefreshConfigRequest) (*RefreshConfigReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshConfig not implemented")
+}
 func (*UnimplementedControlServer) GetEnvironments(ctx context.Context, req *GetEnvironmentsRequest) (*GetEnvironmentsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironments not implemented")
 }
@@ -3513,6 +4687,9 @@ func (*UnimplementedControlServer) NewEnvironment(ctx context.Context, req *NewE
 func (*UnimplementedControlServer) GetEnvironment(ctx context.Context, req *GetEnvironmentRequest) (*GetEnvironmentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironment not implemented")
 }
+func (*UnimplementedControlServer) GetEnvironmentTopology(ctx context.Context, req *GetEnvironmentTopologyRequest) (*GetEnvironmentTopologyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnvironmentTopology not implemented")
+}
 func (*UnimplementedControlServer) ControlEnvironment(ctx context.Context, req *ControlEnvironmentRequest) (*ControlEnvironmentReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ControlEnvironment not implemented")
 }
@@ -3537,6 +4714,9 @@ func (*UnimplementedControlServer) GetRoles(ctx context.Context, req *GetRolesRe
 func (*UnimplementedControlServer) GetWorkflowTemplates(ctx context.Context, req *GetWorkflowTemplatesRequest) (*GetWorkflowTemplatesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowTemplates not implemented")
 }
+func (*UnimplementedControlServer) RenderWorkflowTemplate(ctx context.Context, req *RenderWorkflowTemplateRequest) (*RenderWorkflowTemplateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderWorkflowTemplate not implemented")
+}
 func (*UnimplementedControlServer) ListRepos(ctx context.Context, req *ListReposRequest) (*ListReposReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRepos not implemented")
 }
@@ -3552,6 +4732,9 @@ func (*UnimplementedControlServer) RefreshRepos(ctx context.Context, req *Refres
 func (*UnimplementedControlServer) SetDefaultRepo(ctx context.Context, req *SetDefaultRepoRequest) (*SetDefaultRepoReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultRepo not implemented")
 }
+func (*UnimplementedControlServer) GetRunConfiguration(ctx context.Context, req *GetRunConfigurationRequest) (*GetRunConfigurationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunConfiguration not implemented")
+}
 
 func RegisterControlServer(s *grpc.Server, srv ControlServer) {
 	s.RegisterService(&_Control_serviceDesc, srv)
@@ -3614,6 +4797,24 @@ func _Control_Teardown_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_RefreshConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RefreshConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/RefreshConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RefreshConfig(ctx, req.(*RefreshConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_GetEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetEnvironmentsRequest)
 	if err := dec(in); err != nil {
@@ -3668,6 +4869,24 @@ func _Control_GetEnvironment_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_GetEnvironmentTopology_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnvironmentTopologyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetEnvironmentTopology(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetEnvironmentTopology",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetEnvironmentTopology(ctx, req.(*GetEnvironmentTopologyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_ControlEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ControlEnvironmentRequest)
 	if err := dec(in); err != nil {
@@ -3812,6 +5031,24 @@ func _Control_GetWorkflowTemplates_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_RenderWorkflowTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderWorkflowTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).RenderWorkflowTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/RenderWorkflowTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).RenderWorkflowTemplate(ctx, req.(*RenderWorkflowTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Control_ListRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListReposRequest)
 	if err := dec(in); err != nil {
@@ -3902,6 +5139,24 @@ func _Control_SetDefaultRepo_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Control_GetRunConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServer).GetRunConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/o2control.Control/GetRunConfiguration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServer).GetRunConfiguration(ctx, req.(*GetRunConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Control_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "o2control.Control",
 	HandlerType: (*ControlServer)(nil),
@@ -3914,6 +5169,10 @@ var _Control_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Teardown",
 			Handler:    _Control_Teardown_Handler,
 		},
+		{
+			MethodName: "RefreshConfig",
+			Handler:    _Control_RefreshConfig_Handler,
+		},
 		{
 			MethodName: "GetEnvironments",
 			Handler:    _Control_GetEnvironments_Handler,
@@ -3926,6 +5185,10 @@ var _Control_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEnvironment",
 			Handler:    _Control_GetEnvironment_Handler,
 		},
+		{
+			MethodName: "GetEnvironmentTopology",
+			Handler:    _Control_GetEnvironmentTopology_Handler,
+		},
 		{
 			MethodName: "ControlEnvironment",
 			Handler:    _Control_ControlEnvironment_Handler,
@@ -3958,6 +5221,10 @@ var _Control_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetWorkflowTemplates",
 			Handler:    _Control_GetWorkflowTemplates_Handler,
 		},
+		{
+			MethodName: "RenderWorkflowTemplate",
+			Handler:    _Control_RenderWorkflowTemplate_Handler,
+		},
 		{
 			MethodName: "ListRepos",
 			Handler:    _Control_ListRepos_Handler,
@@ -3978,6 +5245,10 @@ var _Control_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetDefaultRepo",
 			Handler:    _Control_SetDefaultRepo_Handler,
 		},
+		{
+			MethodName: "GetRunConfiguration",
+			Handler:    _Control_GetRunConfiguration_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4379,7 +5650,7 @@ func (m *TeardownReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetEnvironmentsRequest) Marshal() (dAtA []byte, err error) {
+func (m *RefreshConfigRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4389,12 +5660,12 @@ func (m *GetEnvironmentsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetEnvironmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RefreshConfigRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetEnvironmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RefreshConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4406,7 +5677,7 @@ func (m *GetEnvironmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GetEnvironmentsReply) Marshal() (dAtA []byte, err error) {
+func (m *SettingChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4416,12 +5687,12 @@ func (m *GetEnvironmentsReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetEnvironmentsReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *SettingChange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetEnvironmentsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SettingChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4430,18 +5701,144 @@ func (m *GetEnvironmentsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Environments) > 0 {
-		for iNdEx := len(m.Environments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Environments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintO2Control(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	if m.RestartRequired {
+		i--
+		if m.RestartRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefreshConfigReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshConfigReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshConfigReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintO2Control(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEnvironmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEnvironmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEnvironmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEnvironmentsReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEnvironmentsReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEnvironmentsReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Environments) > 0 {
+		for iNdEx := len(m.Environments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Environments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintO2Control(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
 	if len(m.FrameworkId) > 0 {
@@ -4478,6 +5875,16 @@ func (m *EnvironmentInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ConfigurationChanged {
+		i--
+		if m.ConfigurationChanged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.CurrentRunNumber != 0 {
 		i = encodeVarintO2Control(dAtA, i, uint64(m.CurrentRunNumber))
 		i--
@@ -4552,6 +5959,25 @@ func (m *NewEnvironmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Vars) > 0 {
+		for k := range m.Vars {
+			v := m.Vars[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintO2Control(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintO2Control(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintO2Control(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.WorkflowTemplate) > 0 {
 		i -= len(m.WorkflowTemplate)
 		copy(dAtA[i:], m.WorkflowTemplate)
@@ -4686,7 +6112,7 @@ func (m *GetEnvironmentReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ControlEnvironmentRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetEnvironmentTopologyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4696,12 +6122,12 @@ func (m *ControlEnvironmentRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ControlEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetEnvironmentTopologyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ControlEnvironmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetEnvironmentTopologyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4710,11 +6136,6 @@ func (m *ControlEnvironmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Type != 0 {
-		i = encodeVarintO2Control(dAtA, i, uint64(m.Type))
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -4725,7 +6146,7 @@ func (m *ControlEnvironmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *ControlEnvironmentReply) Marshal() (dAtA []byte, err error) {
+func (m *TopologyNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4735,12 +6156,12 @@ func (m *ControlEnvironmentReply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ControlEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopologyNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ControlEnvironmentReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopologyNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4749,29 +6170,52 @@ func (m *ControlEnvironmentReply) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CurrentRunNumber != 0 {
-		i = encodeVarintO2Control(dAtA, i, uint64(m.CurrentRunNumber))
-		i--
-		dAtA[i] = 0x18
-	}
 	if len(m.State) > 0 {
 		i -= len(m.State)
 		copy(dAtA[i:], m.State)
 		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
 		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClassName) > 0 {
+		i -= len(m.ClassName)
+		copy(dAtA[i:], m.ClassName)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ClassName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Hostname) > 0 {
+		i -= len(m.Hostname)
+		copy(dAtA[i:], m.Hostname)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Hostname)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RolePath) > 0 {
+		i -= len(m.RolePath)
+		copy(dAtA[i:], m.RolePath)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.RolePath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ModifyEnvironmentRequest) Marshal() (dAtA []byte, err error) {
+func (m *TopologyEdge) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4781,12 +6225,12 @@ func (m *ModifyEnvironmentRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ModifyEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TopologyEdge) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ModifyEnvironmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TopologyEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4795,41 +6239,52 @@ func (m *ModifyEnvironmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ReconfigureAll {
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Endpoint)))
 		i--
-		if m.ReconfigureAll {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x32
+	}
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Target)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x2a
 	}
-	if len(m.Operations) > 0 {
-		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintO2Control(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TargetTaskId) > 0 {
+		i -= len(m.TargetTaskId)
+		copy(dAtA[i:], m.TargetTaskId)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TargetTaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceTaskId) > 0 {
+		i -= len(m.SourceTaskId)
+		copy(dAtA[i:], m.SourceTaskId)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.SourceTaskId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EnvironmentOperation) Marshal() (dAtA []byte, err error) {
+func (m *GetEnvironmentTopologyReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4839,12 +6294,12 @@ func (m *EnvironmentOperation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EnvironmentOperation) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetEnvironmentTopologyReply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EnvironmentOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetEnvironmentTopologyReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4853,12 +6308,217 @@ func (m *EnvironmentOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RoleName) > 0 {
-		i -= len(m.RoleName)
-		copy(dAtA[i:], m.RoleName)
-		i = encodeVarintO2Control(dAtA, i, uint64(len(m.RoleName)))
+	if len(m.Edges) > 0 {
+		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintO2Control(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintO2Control(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EnvironmentId) > 0 {
+		i -= len(m.EnvironmentId)
+		copy(dAtA[i:], m.EnvironmentId)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.EnvironmentId)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControlEnvironmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControlEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControlEnvironmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Type != 0 {
+		i = encodeVarintO2Control(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControlEnvironmentReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControlEnvironmentReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControlEnvironmentReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CurrentRunNumber != 0 {
+		i = encodeVarintO2Control(dAtA, i, uint64(m.CurrentRunNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModifyEnvironmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModifyEnvironmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyEnvironmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReconfigureAll {
+		i--
+		if m.ReconfigureAll {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintO2Control(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnvironmentOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnvironmentOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnvironmentOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RoleName) > 0 {
+		i -= len(m.RoleName)
+		copy(dAtA[i:], m.RoleName)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.RoleName)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.Type != 0 {
 		i = encodeVarintO2Control(dAtA, i, uint64(m.Type))
@@ -5705,6 +7365,37 @@ func (m *RoleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Vars) > 0 {
+		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintO2Control(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PruneReason) > 0 {
+		i -= len(m.PruneReason)
+		copy(dAtA[i:], m.PruneReason)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.PruneReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Pruned {
+		i--
+		if m.Pruned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
 	if len(m.Roles) > 0 {
 		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -5759,6 +7450,54 @@ func (m *RoleInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VarInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VarInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VarInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GetRolesReply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5909,7 +7648,7 @@ func (m *GetWorkflowTemplatesReply) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *ListReposRequest) Marshal() (dAtA []byte, err error) {
+func (m *RenderWorkflowTemplateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5919,12 +7658,12 @@ func (m *ListReposRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListReposRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *RenderWorkflowTemplateRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListReposRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RenderWorkflowTemplateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5933,10 +7672,36 @@ func (m *ListReposRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Vars) > 0 {
+		for k := range m.Vars {
+			v := m.Vars[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintO2Control(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintO2Control(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintO2Control(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WorkflowTemplate) > 0 {
+		i -= len(m.WorkflowTemplate)
+		copy(dAtA[i:], m.WorkflowTemplate)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.WorkflowTemplate)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
+func (m *RenderedChannel) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5946,12 +7711,12 @@ func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RenderedChannel) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RepoInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RenderedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5960,8 +7725,250 @@ func (m *RepoInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Default {
-		i--
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenderedRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderedRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenderedRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PruneReason) > 0 {
+		i -= len(m.PruneReason)
+		copy(dAtA[i:], m.PruneReason)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.PruneReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Pruned {
+		i--
+		if m.Pruned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintO2Control(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OutboundChannels) > 0 {
+		for iNdEx := len(m.OutboundChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutboundChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintO2Control(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Vars) > 0 {
+		for k := range m.Vars {
+			v := m.Vars[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintO2Control(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintO2Control(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintO2Control(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TaskClass) > 0 {
+		i -= len(m.TaskClass)
+		copy(dAtA[i:], m.TaskClass)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskClass)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FullPath) > 0 {
+		i -= len(m.FullPath)
+		copy(dAtA[i:], m.FullPath)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.FullPath)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RenderWorkflowTemplateReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RenderWorkflowTemplateReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RenderWorkflowTemplateReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Problems) > 0 {
+		for iNdEx := len(m.Problems) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Problems[iNdEx])
+			copy(dAtA[i:], m.Problems[iNdEx])
+			i = encodeVarintO2Control(dAtA, i, uint64(len(m.Problems[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintO2Control(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkflowRevision) > 0 {
+		i -= len(m.WorkflowRevision)
+		copy(dAtA[i:], m.WorkflowRevision)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.WorkflowRevision)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListReposRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListReposRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListReposRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Default {
+		i--
 		if m.Default {
 			dAtA[i] = 1
 		} else {
@@ -6079,6 +8086,15 @@ func (m *AddRepoReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ValidationErrors) > 0 {
+		for iNdEx := len(m.ValidationErrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidationErrors[iNdEx])
+			copy(dAtA[i:], m.ValidationErrors[iNdEx])
+			i = encodeVarintO2Control(dAtA, i, uint64(len(m.ValidationErrors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ErrorString) > 0 {
 		i -= len(m.ErrorString)
 		copy(dAtA[i:], m.ErrorString)
@@ -6221,6 +8237,15 @@ func (m *RefreshReposReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ValidationErrors) > 0 {
+		for iNdEx := len(m.ValidationErrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidationErrors[iNdEx])
+			copy(dAtA[i:], m.ValidationErrors[iNdEx])
+			i = encodeVarintO2Control(dAtA, i, uint64(len(m.ValidationErrors[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ErrorString) > 0 {
 		i -= len(m.ErrorString)
 		copy(dAtA[i:], m.ErrorString)
@@ -6297,39 +8322,219 @@ func (m *SetDefaultRepoReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintO2Control(dAtA []byte, offset int, v uint64) int {
-	offset -= sovO2Control(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetRunConfigurationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Event_MesosHeartbeat) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *GetRunConfigurationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetRunConfigurationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.RunNumber != 0 {
+		i = encodeVarintO2Control(dAtA, i, uint64(m.RunNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RunTaskConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RunTaskConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RunTaskConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Properties) > 0 {
+		for k := range m.Properties {
+			v := m.Properties[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintO2Control(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintO2Control(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintO2Control(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Hostname) > 0 {
+		i -= len(m.Hostname)
+		copy(dAtA[i:], m.Hostname)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Hostname)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ClassName) > 0 {
+		i -= len(m.ClassName)
+		copy(dAtA[i:], m.ClassName)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.ClassName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetRunConfigurationReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRunConfigurationReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRunConfigurationReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintO2Control(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.StartedWhen) > 0 {
+		i -= len(m.StartedWhen)
+		copy(dAtA[i:], m.StartedWhen)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.StartedWhen)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.WorkflowRevision) > 0 {
+		i -= len(m.WorkflowRevision)
+		copy(dAtA[i:], m.WorkflowRevision)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.WorkflowRevision)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.WorkflowTemplate) > 0 {
+		i -= len(m.WorkflowTemplate)
+		copy(dAtA[i:], m.WorkflowTemplate)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.WorkflowTemplate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EnvironmentId) > 0 {
+		i -= len(m.EnvironmentId)
+		copy(dAtA[i:], m.EnvironmentId)
+		i = encodeVarintO2Control(dAtA, i, uint64(len(m.EnvironmentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RunNumber != 0 {
+		i = encodeVarintO2Control(dAtA, i, uint64(m.RunNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintO2Control(dAtA []byte, offset int, v uint64) int {
+	offset -= sovO2Control(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Event_MesosHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
 func (m *StatusReply) Size() (n int) {
@@ -6494,6 +8699,63 @@ func (m *TeardownReply) Size() (n int) {
 	return n
 }
 
+func (m *RefreshConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SettingChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.RestartRequired {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshConfigReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GetEnvironmentsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6559,6 +8821,9 @@ func (m *EnvironmentInfo) Size() (n int) {
 	if m.CurrentRunNumber != 0 {
 		n += 1 + sovO2Control(uint64(m.CurrentRunNumber))
 	}
+	if m.ConfigurationChanged {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6575,6 +8840,14 @@ func (m *NewEnvironmentRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovO2Control(uint64(l))
 	}
+	if len(m.Vars) > 0 {
+		for k, v := range m.Vars {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovO2Control(uint64(len(k))) + 1 + len(v) + sovO2Control(uint64(len(v)))
+			n += mapEntrySize + 1 + sovO2Control(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6633,7 +8906,7 @@ func (m *GetEnvironmentReply) Size() (n int) {
 	return n
 }
 
-func (m *ControlEnvironmentRequest) Size() (n int) {
+func (m *GetEnvironmentTopologyRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -6643,31 +8916,41 @@ func (m *ControlEnvironmentRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovO2Control(uint64(l))
 	}
-	if m.Type != 0 {
-		n += 1 + sovO2Control(uint64(m.Type))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ControlEnvironmentReply) Size() (n int) {
+func (m *TopologyNode) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.TaskId)
 	if l > 0 {
 		n += 1 + l + sovO2Control(uint64(l))
 	}
-	l = len(m.State)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovO2Control(uint64(l))
 	}
-	if m.CurrentRunNumber != 0 {
-		n += 1 + sovO2Control(uint64(m.CurrentRunNumber))
+	l = len(m.RolePath)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.ClassName)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -6675,24 +8958,35 @@ func (m *ControlEnvironmentReply) Size() (n int) {
 	return n
 }
 
-func (m *ModifyEnvironmentRequest) Size() (n int) {
+func (m *TopologyEdge) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.SourceTaskId)
 	if l > 0 {
 		n += 1 + l + sovO2Control(uint64(l))
 	}
-	if len(m.Operations) > 0 {
-		for _, e := range m.Operations {
-			l = e.Size()
-			n += 1 + l + sovO2Control(uint64(l))
-		}
+	l = len(m.TargetTaskId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
 	}
-	if m.ReconfigureAll {
-		n += 2
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -6700,13 +8994,108 @@ func (m *ModifyEnvironmentRequest) Size() (n int) {
 	return n
 }
 
-func (m *EnvironmentOperation) Size() (n int) {
+func (m *GetEnvironmentTopologyReply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
+	l = len(m.EnvironmentId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ControlEnvironmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovO2Control(uint64(m.Type))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ControlEnvironmentReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.CurrentRunNumber != 0 {
+		n += 1 + sovO2Control(uint64(m.CurrentRunNumber))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ModifyEnvironmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.ReconfigureAll {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EnvironmentOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
 		n += 1 + sovO2Control(uint64(m.Type))
 	}
 	l = len(m.RoleName)
@@ -7128,6 +9517,43 @@ func (m *RoleInfo) Size() (n int) {
 			n += 1 + l + sovO2Control(uint64(l))
 		}
 	}
+	if m.Pruned {
+		n += 2
+	}
+	l = len(m.PruneReason)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Vars) > 0 {
+		for _, e := range m.Vars {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VarInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7202,6 +9628,131 @@ func (m *GetWorkflowTemplatesReply) Size() (n int) {
 	return n
 }
 
+func (m *RenderWorkflowTemplateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowTemplate)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Vars) > 0 {
+		for k, v := range m.Vars {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovO2Control(uint64(len(k))) + 1 + len(v) + sovO2Control(uint64(len(v)))
+			n += mapEntrySize + 1 + sovO2Control(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderedRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.FullPath)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.TaskClass)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Vars) > 0 {
+		for k, v := range m.Vars {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovO2Control(uint64(len(k))) + 1 + len(v) + sovO2Control(uint64(len(v)))
+			n += mapEntrySize + 1 + sovO2Control(uint64(mapEntrySize))
+		}
+	}
+	if len(m.OutboundChannels) > 0 {
+		for _, e := range m.OutboundChannels {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.Pruned {
+		n += 2
+	}
+	l = len(m.PruneReason)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RenderWorkflowTemplateReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowRevision)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Problems) > 0 {
+		for _, s := range m.Problems {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *ListReposRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7277,6 +9828,12 @@ func (m *AddRepoReply) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovO2Control(uint64(l))
 	}
+	if len(m.ValidationErrors) > 0 {
+		for _, s := range m.ValidationErrors {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7342,6 +9899,12 @@ func (m *RefreshReposReply) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovO2Control(uint64(l))
 	}
+	if len(m.ValidationErrors) > 0 {
+		for _, s := range m.ValidationErrors {
+			l = len(s)
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -7379,21 +9942,109 @@ func (m *SetDefaultRepoReply) Size() (n int) {
 	return n
 }
 
-func sovO2Control(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozO2Control(x uint64) (n int) {
-	return sovO2Control(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *GetRunConfigurationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunNumber != 0 {
+		n += 1 + sovO2Control(uint64(m.RunNumber))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *Event_MesosHeartbeat) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+
+func (m *RunTaskConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.ClassName)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Properties) > 0 {
+		for k, v := range m.Properties {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovO2Control(uint64(len(k))) + 1 + len(v) + sovO2Control(uint64(len(v)))
+			n += mapEntrySize + 1 + sovO2Control(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetRunConfigurationReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunNumber != 0 {
+		n += 1 + sovO2Control(uint64(m.RunNumber))
+	}
+	l = len(m.EnvironmentId)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.WorkflowTemplate)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.WorkflowRevision)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	l = len(m.StartedWhen)
+	if l > 0 {
+		n += 1 + l + sovO2Control(uint64(l))
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovO2Control(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovO2Control(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozO2Control(x uint64) (n int) {
+	return sovO2Control(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Event_MesosHeartbeat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -8365,7 +11016,7 @@ func (m *TeardownReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetEnvironmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *RefreshConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8388,10 +11039,10 @@ func (m *GetEnvironmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetEnvironmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEnvironmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8419,7 +11070,7 @@ func (m *GetEnvironmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
+func (m *SettingChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8442,15 +11093,15 @@ func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetEnvironmentsReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: SettingChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEnvironmentsReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SettingChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FrameworkId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8478,13 +11129,13 @@ func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FrameworkId = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environments", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -8494,26 +11145,76 @@ func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Environments = append(m.Environments, &EnvironmentInfo{})
-			if err := m.Environments[len(m.Environments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OldValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartRequired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -8539,7 +11240,7 @@ func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
+func (m *RefreshConfigReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8562,17 +11263,17 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvironmentInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshConfigReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvironmentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshConfigReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -8582,173 +11283,80 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedWhen", wireType)
+			m.Changes = append(m.Changes, &SettingChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedWhen = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEnvironmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Tasks = append(m.Tasks, &ShortTaskInfo{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootRole", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RootRole = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRunNumber", wireType)
-			}
-			m.CurrentRunNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentRunNumber |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEnvironmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEnvironmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -8774,7 +11382,7 @@ func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewEnvironmentRequest) Unmarshal(dAtA []byte) error {
+func (m *GetEnvironmentsReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8797,15 +11405,15 @@ func (m *NewEnvironmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewEnvironmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetEnvironmentsReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetEnvironmentsReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrameworkId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8833,65 +11441,11 @@ func (m *NewEnvironmentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowTemplate = string(dAtA[iNdEx:postIndex])
+			m.FrameworkId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NewEnvironmentReply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NewEnvironmentReply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Environments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8918,10 +11472,8 @@ func (m *NewEnvironmentReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Environment == nil {
-				m.Environment = &EnvironmentInfo{}
-			}
-			if err := m.Environment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Environments = append(m.Environments, &EnvironmentInfo{})
+			if err := m.Environments[len(m.Environments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8950,7 +11502,7 @@ func (m *NewEnvironmentReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetEnvironmentRequest) Unmarshal(dAtA []byte) error {
+func (m *EnvironmentInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8973,10 +11525,10 @@ func (m *GetEnvironmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetEnvironmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnvironmentInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnvironmentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9011,65 +11563,11 @@ func (m *GetEnvironmentRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetEnvironmentReply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetEnvironmentReply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedWhen", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9079,33 +11577,29 @@ func (m *GetEnvironmentReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Environment == nil {
-				m.Environment = &EnvironmentInfo{}
-			}
-			if err := m.Environment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CreatedWhen = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9115,85 +11609,61 @@ func (m *GetEnvironmentReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Workflow == nil {
-				m.Workflow = &RoleInfo{}
-			}
-			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ControlEnvironmentRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Tasks = append(m.Tasks, &ShortTaskInfo{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ControlEnvironmentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControlEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootRole", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9221,13 +11691,13 @@ func (m *ControlEnvironmentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.RootRole = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRunNumber", wireType)
 			}
-			m.Type = 0
+			m.CurrentRunNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9237,11 +11707,31 @@ func (m *ControlEnvironmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= ControlEnvironmentRequest_Optype(b&0x7F) << shift
+				m.CurrentRunNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationChanged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ConfigurationChanged = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -9267,7 +11757,7 @@ func (m *ControlEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
+func (m *NewEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9290,15 +11780,15 @@ func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ControlEnvironmentReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: NewEnvironmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ControlEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NewEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9326,13 +11816,13 @@ func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.WorkflowTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9342,43 +11832,119 @@ func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRunNumber", wireType)
+			if m.Vars == nil {
+				m.Vars = make(map[string]string)
 			}
-			m.CurrentRunNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowO2Control
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentRunNumber |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipO2Control(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
+			m.Vars[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -9404,7 +11970,7 @@ func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyEnvironmentRequest) Unmarshal(dAtA []byte) error {
+func (m *NewEnvironmentReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9427,47 +11993,15 @@ func (m *ModifyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyEnvironmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NewEnvironmentReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NewEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9494,31 +12028,13 @@ func (m *ModifyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Operations = append(m.Operations, &EnvironmentOperation{})
-			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Environment == nil {
+				m.Environment = &EnvironmentInfo{}
+			}
+			if err := m.Environment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReconfigureAll", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ReconfigureAll = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -9544,7 +12060,7 @@ func (m *ModifyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
+func (m *GetEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9567,17 +12083,17 @@ func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnvironmentOperation: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetEnvironmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnvironmentOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9587,33 +12103,14 @@ func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= EnvironmentOperation_Optype(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
 			}
 			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
@@ -9622,7 +12119,7 @@ func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleName = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9649,7 +12146,7 @@ func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
+func (m *GetEnvironmentReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9672,15 +12169,15 @@ func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModifyEnvironmentReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetEnvironmentReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModifyEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedOperations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Environment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9707,16 +12204,18 @@ func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailedOperations = append(m.FailedOperations, &EnvironmentOperation{})
-			if err := m.FailedOperations[len(m.FailedOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Environment == nil {
+				m.Environment = &EnvironmentInfo{}
+			}
+			if err := m.Environment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9726,55 +12225,27 @@ func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
+			if m.Workflow == nil {
+				m.Workflow = &RoleInfo{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9801,7 +12272,7 @@ func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
+func (m *GetEnvironmentTopologyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9824,10 +12295,10 @@ func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DestroyEnvironmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetEnvironmentTopologyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DestroyEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetEnvironmentTopologyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9862,26 +12333,6 @@ func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeepTasks", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.KeepTasks = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -9907,7 +12358,7 @@ func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DestroyEnvironmentReply) Unmarshal(dAtA []byte) error {
+func (m *TopologyNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9930,17 +12381,17 @@ func (m *DestroyEnvironmentReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DestroyEnvironmentReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopologyNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DestroyEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopologyNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CleanupTasksReply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -9950,83 +12401,25 @@ func (m *DestroyEnvironmentReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CleanupTasksReply == nil {
-				m.CleanupTasksReply = &CleanupTasksReply{}
-			}
-			if err := m.CleanupTasksReply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ShortTaskInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShortTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -10058,29 +12451,9 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Locked = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolePath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10108,11 +12481,11 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.RolePath = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10140,11 +12513,11 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10172,11 +12545,11 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10204,43 +12577,7 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DeploymentInfo == nil {
-				m.DeploymentInfo = &TaskDeploymentInfo{}
-			}
-			if err := m.DeploymentInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10267,7 +12604,7 @@ func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
+func (m *TopologyEdge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10290,15 +12627,15 @@ func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskDeploymentInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TopologyEdge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskDeploymentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TopologyEdge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10326,11 +12663,11 @@ func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hostname = string(dAtA[iNdEx:postIndex])
+			m.SourceTaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetTaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10358,11 +12695,11 @@ func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
+			m.TargetTaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10390,11 +12727,11 @@ func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OfferId = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10422,62 +12759,72 @@ func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTasksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTasksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -10503,7 +12850,7 @@ func (m *GetTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTasksReply) Unmarshal(dAtA []byte) error {
+func (m *GetEnvironmentTopologyReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10526,17 +12873,17 @@ func (m *GetTasksReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTasksReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetEnvironmentTopologyReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTasksReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetEnvironmentTopologyReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvironmentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -10546,85 +12893,63 @@ func (m *GetTasksReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &ShortTaskInfo{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EnvironmentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTaskRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Nodes = append(m.Nodes, &TopologyNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -10634,23 +12959,25 @@ func (m *GetTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.Edges = append(m.Edges, &TopologyEdge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10677,7 +13004,7 @@ func (m *GetTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskReply) Unmarshal(dAtA []byte) error {
+func (m *ControlEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10700,17 +13027,17 @@ func (m *GetTaskReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControlEnvironmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControlEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -10720,28 +13047,43 @@ func (m *GetTaskReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &TaskInfo{}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ControlEnvironmentRequest_Optype(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -10767,7 +13109,7 @@ func (m *GetTaskReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
+func (m *ControlEnvironmentReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10790,15 +13132,15 @@ func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskClassInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ControlEnvironmentReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskClassInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ControlEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10826,11 +13168,11 @@ func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlMode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10858,8 +13200,27 @@ func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControlMode = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRunNumber", wireType)
+			}
+			m.CurrentRunNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRunNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -10885,7 +13246,7 @@ func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommandInfo) Unmarshal(dAtA []byte) error {
+func (m *ModifyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10908,15 +13269,15 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommandInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyEnvironmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommandInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10944,13 +13305,13 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, string(dAtA[iNdEx:postIndex]))
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -10960,17 +13321,31 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Shell = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, &EnvironmentOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReconfigureAll", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -10980,29 +13355,71 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.ReconfigureAll = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnvironmentOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnvironmentOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnvironmentOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -11012,27 +13429,14 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Type |= EnvironmentOperation_Optype(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Arguments = append(m.Arguments, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11060,7 +13464,7 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.User = string(dAtA[iNdEx:postIndex])
+			m.RoleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11087,7 +13491,7 @@ func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
+func (m *ModifyEnvironmentReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11110,17 +13514,17 @@ func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModifyEnvironmentReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModifyEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedOperations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -11130,27 +13534,29 @@ func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.FailedOperations = append(m.FailedOperations, &EnvironmentOperation{})
+			if err := m.FailedOperations[len(m.FailedOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11178,11 +13584,11 @@ func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11210,7 +13616,7 @@ func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11237,7 +13643,7 @@ func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskInfo) Unmarshal(dAtA []byte) error {
+func (m *DestroyEnvironmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11260,17 +13666,17 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: DestroyEnvironmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DestroyEnvironmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShortInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -11280,33 +13686,29 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShortInfo == nil {
-				m.ShortInfo = &ShortTaskInfo{}
-			}
-			if err := m.ShortInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepTasks", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -11316,99 +13718,69 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ClassInfo == nil {
-				m.ClassInfo = &TaskClassInfo{}
-			}
-			if err := m.ClassInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeepTasks = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InboundChannels = append(m.InboundChannels, &ChannelInfo{})
-			if err := m.InboundChannels[len(m.InboundChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DestroyEnvironmentReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundChannels = append(m.OutboundChannels, &ChannelInfo{})
-			if err := m.OutboundChannels[len(m.OutboundChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DestroyEnvironmentReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DestroyEnvironmentReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommandInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CleanupTasksReply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11435,77 +13807,13 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommandInfo == nil {
-				m.CommandInfo = &CommandInfo{}
+			if m.CleanupTasksReply == nil {
+				m.CleanupTasksReply = &CleanupTasksReply{}
 			}
-			if err := m.CommandInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CleanupTasksReply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskPath", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EnvId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -11531,7 +13839,7 @@ func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CleanupTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *ShortTaskInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11554,15 +13862,15 @@ func (m *CleanupTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CleanupTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShortTaskInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CleanupTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShortTaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11590,67 +13898,33 @@ func (m *CleanupTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CleanupTasksReply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CleanupTasksReply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CleanupTasksReply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Locked = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KilledTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -11660,31 +13934,29 @@ func (m *CleanupTasksReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KilledTasks = append(m.KilledTasks, &ShortTaskInfo{})
-			if err := m.KilledTasks[len(m.KilledTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunningTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -11694,83 +13966,59 @@ func (m *CleanupTasksReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunningTasks = append(m.RunningTasks, &ShortTaskInfo{})
-			if err := m.RunningTasks[len(m.RunningTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetRolesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRolesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11798,13 +14046,13 @@ func (m *GetRolesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EnvId = string(dAtA[iNdEx:postIndex])
+			m.ClassName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PathSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -11814,23 +14062,27 @@ func (m *GetRolesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathSpec = string(dAtA[iNdEx:postIndex])
+			if m.DeploymentInfo == nil {
+				m.DeploymentInfo = &TaskDeploymentInfo{}
+			}
+			if err := m.DeploymentInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11857,7 +14109,7 @@ func (m *GetRolesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RoleInfo) Unmarshal(dAtA []byte) error {
+func (m *TaskDeploymentInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11880,15 +14132,15 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoleInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskDeploymentInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskDeploymentInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11916,11 +14168,11 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11948,11 +14200,11 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11980,11 +14232,11 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.OfferId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12012,74 +14264,62 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FullPath = string(dAtA[iNdEx:postIndex])
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, &RoleInfo{})
-			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -12105,7 +14345,7 @@ func (m *RoleInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRolesReply) Unmarshal(dAtA []byte) error {
+func (m *GetTasksReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12128,15 +14368,15 @@ func (m *GetRolesReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRolesReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTasksReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRolesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTasksReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12163,8 +14403,8 @@ func (m *GetRolesReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, &RoleInfo{})
-			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tasks = append(m.Tasks, &ShortTaskInfo{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12193,7 +14433,7 @@ func (m *GetRolesReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkflowTemplatesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTaskRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12216,101 +14456,15 @@ func (m *GetWorkflowTemplatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowTemplatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkflowTemplateInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkflowTemplateInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkflowTemplateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12338,7 +14492,7 @@ func (m *WorkflowTemplateInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12365,7 +14519,7 @@ func (m *WorkflowTemplateInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkflowTemplatesReply) Unmarshal(dAtA []byte) error {
+func (m *GetTaskReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12388,15 +14542,15 @@ func (m *GetWorkflowTemplatesReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowTemplatesReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowTemplatesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12423,65 +14577,13 @@ func (m *GetWorkflowTemplatesReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowTemplates = append(m.WorkflowTemplates, &WorkflowTemplateInfo{})
-			if err := m.WorkflowTemplates[len(m.WorkflowTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Task == nil {
+				m.Task = &TaskInfo{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListReposRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListReposRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListReposRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -12507,7 +14609,7 @@ func (m *ListReposRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+func (m *TaskClassInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12530,10 +14632,10 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskClassInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskClassInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12569,84 +14671,10 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowO2Control
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Default = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthO2Control
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListReposReply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListReposReply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListReposReply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlMode", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -12656,25 +14684,23 @@ func (m *ListReposReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repos = append(m.Repos, &RepoInfo{})
-			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ControlMode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12701,7 +14727,7 @@ func (m *ListReposReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *CommandInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12724,15 +14750,15 @@ func (m *AddRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommandInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommandInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12760,65 +14786,95 @@ func (m *AddRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Env = append(m.Env, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
 			}
-			if skippy < 0 {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Shell = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddRepoReply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddRepoReply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddRepoReply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arguments = append(m.Arguments, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12846,7 +14902,7 @@ func (m *AddRepoReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorString = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12873,7 +14929,7 @@ func (m *AddRepoReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *ChannelInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12896,17 +14952,17 @@ func (m *RemoveRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.Index = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -12916,70 +14972,29 @@ func (m *RemoveRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RemoveRepoReply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveRepoReply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveRepoReply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -12989,15 +15004,27 @@ func (m *RemoveRepoReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Ok = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewDefaultRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13025,7 +15052,7 @@ func (m *RemoveRepoReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewDefaultRepo = string(dAtA[iNdEx:postIndex])
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13052,7 +15079,7 @@ func (m *RemoveRepoReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RefreshReposRequest) Unmarshal(dAtA []byte) error {
+func (m *TaskInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13075,17 +15102,17 @@ func (m *RefreshReposRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshReposRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshReposRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortInfo", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -13095,68 +15122,171 @@ func (m *RefreshReposRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipO2Control(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RefreshReposReply) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowO2Control
+			if m.ShortInfo == nil {
+				m.ShortInfo = &ShortTaskInfo{}
 			}
-			if iNdEx >= l {
+			if err := m.ShortInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.ClassInfo == nil {
+				m.ClassInfo = &TaskClassInfo{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshReposReply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshReposReply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.ClassInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InboundChannels = append(m.InboundChannels, &ChannelInfo{})
+			if err := m.InboundChannels[len(m.InboundChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundChannels = append(m.OutboundChannels, &ChannelInfo{})
+			if err := m.OutboundChannels[len(m.OutboundChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommandInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CommandInfo == nil {
+				m.CommandInfo = &CommandInfo{}
+			}
+			if err := m.CommandInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskPath", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13184,7 +15314,39 @@ func (m *RefreshReposReply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorString = string(dAtA[iNdEx:postIndex])
+			m.TaskPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13211,7 +15373,7 @@ func (m *RefreshReposReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetDefaultRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *CleanupTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13234,17 +15396,17 @@ func (m *SetDefaultRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetDefaultRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanupTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetDefaultRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanupTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
 			}
-			m.Index = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -13254,11 +15416,24 @@ func (m *SetDefaultRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipO2Control(dAtA[iNdEx:])
@@ -13284,7 +15459,7 @@ func (m *SetDefaultRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetDefaultRepoReply) Unmarshal(dAtA []byte) error {
+func (m *CleanupTasksReply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13307,17 +15482,17 @@ func (m *SetDefaultRepoReply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetDefaultRepoReply: wiretype end group for non-group")
+			return fmt.Errorf("proto: CleanupTasksReply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetDefaultRepoReply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CleanupTasksReply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorString", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KilledTasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowO2Control
@@ -13327,23 +15502,3521 @@ func (m *SetDefaultRepoReply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthO2Control
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthO2Control
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorString = string(dAtA[iNdEx:postIndex])
+			m.KilledTasks = append(m.KilledTasks, &ShortTaskInfo{})
+			if err := m.KilledTasks[len(m.KilledTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunningTasks = append(m.RunningTasks, &ShortTaskInfo{})
+			if err := m.RunningTasks[len(m.RunningTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRolesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRolesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathSpec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FullPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, &RoleInfo{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pruned = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruneReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vars = append(m.Vars, &VarInfo{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VarInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VarInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VarInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRolesReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRolesReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRolesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, &RoleInfo{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowTemplatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowTemplatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowTemplatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTemplateInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTemplateInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTemplateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowTemplatesReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowTemplatesReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowTemplatesReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTemplates = append(m.WorkflowTemplates, &WorkflowTemplateInfo{})
+			if err := m.WorkflowTemplates[len(m.WorkflowTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderWorkflowTemplateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderWorkflowTemplateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderWorkflowTemplateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vars == nil {
+				m.Vars = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowO2Control
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipO2Control(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Vars[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderedRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderedRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderedRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FullPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskClass", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskClass = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vars == nil {
+				m.Vars = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowO2Control
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipO2Control(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Vars[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundChannels = append(m.OutboundChannels, &RenderedChannel{})
+			if err := m.OutboundChannels[len(m.OutboundChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, &RenderedRole{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pruned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pruned = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruneReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenderWorkflowTemplateReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenderWorkflowTemplateReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenderWorkflowTemplateReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Workflow == nil {
+				m.Workflow = &RenderedRole{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Problems", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Problems = append(m.Problems, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListReposRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListReposRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListReposRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Default = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListReposReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListReposReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListReposReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, &RepoInfo{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddRepoReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddRepoReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddRepoReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationErrors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidationErrors = append(m.ValidationErrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveRepoReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveRepoReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveRepoReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDefaultRepo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewDefaultRepo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshReposRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshReposRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshReposRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefreshReposReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefreshReposReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefreshReposReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationErrors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidationErrors = append(m.ValidationErrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDefaultRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDefaultRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDefaultRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDefaultRepoReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDefaultRepoReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDefaultRepoReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorString", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorString = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRunConfigurationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRunConfigurationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRunConfigurationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunNumber", wireType)
+			}
+			m.RunNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RunTaskConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RunTaskConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RunTaskConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Properties == nil {
+				m.Properties = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowO2Control
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowO2Control
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipO2Control(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthO2Control
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Properties[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipO2Control(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRunConfigurationReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowO2Control
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRunConfigurationReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRunConfigurationReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunNumber", wireType)
+			}
+			m.RunNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvironmentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnvironmentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowTemplate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowRevision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowRevision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedWhen", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedWhen = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowO2Control
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthO2Control
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &RunTaskConfiguration{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
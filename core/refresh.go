@@ -0,0 +1,139 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+// refreshableSettings are the core settings which take effect as soon as the
+// core configuration is reloaded: most are read every time they are needed,
+// the metrics server is restarted when one of its settings changes.
+// Any other setting is only read at startup. This includes defaultRepo, which
+// is only a fallback for the default_repo in the configuration backend.
+var refreshableSettings = []string{
+	"deploymentTimeout",
+	"instanceName",
+	"mesosCommandTimeout",
+	"mesosResourceTypeMetrics",
+	"metrics.address",
+	"metrics.path",
+	"metrics.port",
+	"verbose",
+	"veryVerbose",
+}
+
+type settingChange struct {
+	key             string
+	oldValue        string
+	newValue        string
+	restartRequired bool
+}
+
+func applyLogLevel() {
+	if viper.GetBool("verbose") || viper.GetBool("veryVerbose") {
+		logrus.SetLevel(logrus.DebugLevel)
+	} else {
+		logrus.SetLevel(logrus.InfoLevel)
+	}
+}
+
+func settingsSnapshot() map[string]string {
+	snapshot := make(map[string]string)
+	for _, key := range viper.AllKeys() {
+		snapshot[key] = fmt.Sprintf("%v", viper.Get(key))
+	}
+	return snapshot
+}
+
+// refreshCoreConfig re-reads the core configuration from coreConfigurationUri,
+// applies whatever can be applied at runtime and returns all the settings
+// whose value changed. Settings passed as command line flags keep precedence
+// over the configuration, as they do at startup.
+func refreshCoreConfig() (changes []settingChange, err error) {
+	before := settingsSnapshot()
+
+	err = parseCoreConfig()
+	if err != nil {
+		return
+	}
+	sanitizeReposPath()
+	applyLogLevel()
+
+	after := settingsSnapshot()
+	keys := make([]string, 0, len(after))
+	for key := range after {
+		keys = append(keys, key)
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if before[key] == after[key] {
+			continue
+		}
+		// viper keys are case insensitive and always returned lowercase
+		restartRequired := true
+		for _, refreshable := range refreshableSettings {
+			if strings.EqualFold(refreshable, key) {
+				restartRequired = false
+				break
+			}
+		}
+		changes = append(changes, settingChange{
+			key:             key,
+			oldValue:        before[key],
+			newValue:        after[key],
+			restartRequired: restartRequired,
+		})
+	}
+
+	for _, change := range changes {
+		if strings.HasPrefix(change.key, "metrics.") {
+			restartMetricsServer()
+			break
+		}
+	}
+
+	for _, change := range changes {
+		log.WithFields(logrus.Fields{
+				"setting": change.key,
+				"old": change.oldValue,
+				"new": change.newValue,
+				"restartRequired": change.restartRequired,
+			}).
+			Info("core setting changed")
+	}
+	return
+}
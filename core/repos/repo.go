@@ -26,10 +26,12 @@ package repos
 
 import (
 	"errors"
+	"github.com/AliceO2Group/Control/core/schema"
 	"github.com/spf13/viper"
 	"gopkg.in/src-d/go-git.v4"
 	"gopkg.in/src-d/go-git.v4/plumbing"
 	"io/ioutil"
+	"os"
 	"strings"
 )
 
@@ -113,6 +115,10 @@ func (r *Repo) getWorkflowDir() string {
 	return r.getCloneDir() + "/workflows/"
 }
 
+func (r *Repo) getTaskDir() string {
+	return r.getCloneDir() + "/tasks/"
+}
+
 func (r *Repo) ResolveTaskClassIdentifier(loadTaskClass string) (taskClassIdentifier string) {
 	if !strings.Contains(loadTaskClass, "/") {
 		taskClassIdentifier = r.HostingSite + "/" + r.User + "/" + r.RepoName + "/tasks/" + loadTaskClass
@@ -163,6 +169,32 @@ func (r *Repo) checkoutRevision(revision string) error {
 	return nil
 }
 
+// ReadFileAtHash returns the contents of a file of the repo as of the commit
+// hash, without touching the checked out revision. path is relative to the
+// root of the repo, e.g. tasks/readout.yaml.
+func (r *Repo) ReadFileAtHash(hash string, path string) ([]byte, error) {
+	ref, err := git.PlainOpen(r.getCloneDir())
+	if err != nil {
+		return nil, errors.New(err.Error() + ": " + r.GetIdentifier())
+	}
+
+	commit, err := ref.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return nil, errors.New("cannot find commit " + hash + " in " + r.GetIdentifier() + ": " + err.Error())
+	}
+
+	file, err := commit.File(path)
+	if err != nil {
+		return nil, errors.New("cannot find " + path + " at " + r.GetIdentifier() + "@" + hash + ": " + err.Error())
+	}
+
+	contents, err := file.Contents()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(contents), nil
+}
+
 func (r *Repo) refresh() error {
 
 	ref, err := git.PlainOpen(r.getCloneDir())
@@ -199,4 +231,47 @@ func (r *Repo) getWorkflows() ([]string, error) {
 		}
 	}
 	return workflows, nil
-}
\ No newline at end of file
+}
+
+// validate checks all the task classes and workflow templates of the repo against
+// their schema, and returns one message for each problem found.
+func (r *Repo) validate() (problems []string) {
+	dirs := []struct{
+		path     string
+		name     string
+		validate func(fileName string, yamlDoc []byte) error
+	}{
+		{r.getTaskDir(), "tasks/", schema.ValidateTaskClass},
+		{r.getWorkflowDir(), "workflows/", schema.ValidateWorkflow},
+	}
+
+	for _, dir := range dirs {
+		files, err := ioutil.ReadDir(dir.path)
+		if err != nil {
+			if !os.IsNotExist(err) {
+				problems = append(problems, err.Error())
+			}
+			continue
+		}
+		for _, file := range files {
+			if file.IsDir() || !strings.HasSuffix(file.Name(), ".yaml") {
+				continue
+			}
+			fileName := r.GetIdentifier() + dir.name + file.Name()
+			yamlDoc, err := ioutil.ReadFile(dir.path + file.Name())
+			if err != nil {
+				problems = append(problems, fileName + ": " + err.Error())
+				continue
+			}
+			err = dir.validate(fileName, yamlDoc)
+			if validationErrs, ok := err.(schema.Errors); ok {
+				for _, validationErr := range validationErrs {
+					problems = append(problems, validationErr.Error())
+				}
+			} else if err != nil {
+				problems = append(problems, err.Error())
+			}
+		}
+	}
+	return
+}
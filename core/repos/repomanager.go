@@ -36,6 +36,7 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 )
@@ -271,7 +272,7 @@ func (manager *RepoManager) RefreshRepoByIndex(index int) error {
 		return repo.refresh()
 	}
 
-	return errors.New("RefreshRepoByIndex: repo not found for index: " + string(index))
+	return errors.New("RefreshRepoByIndex: repo not found for index: " + strconv.Itoa(index))
 }
 
 func (manager *RepoManager) GetWorkflow(workflowPath string)  (resolvedWorkflowPath string, workflowRepo *Repo, err error) {
@@ -323,6 +324,19 @@ func (manager *RepoManager) GetWorkflow(workflowPath string)  (resolvedWorkflowP
 	return
 }
 
+// CheckoutRevision moves a known repo to another revision. It is meant for
+// callers which need to put a repo back where it was after GetWorkflow
+// checked out a pinned revision.
+func (manager *RepoManager) CheckoutRevision(repo *Repo, revision string) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	if repo == nil || manager.repoList[repo.GetIdentifier()] != repo {
+		return errors.New("cannot check out revision of unknown repo")
+	}
+	return repo.checkoutRevision(revision)
+}
+
 func (manager *RepoManager) setDefaultRepo(repo *Repo) {
 	if manager.defaultRepo != nil {
 		manager.defaultRepo.Default = false // Update old default repo
@@ -367,31 +381,26 @@ func (manager *RepoManager) UpdateDefaultRepo(repoPath string) error { //unused
 }
 
 func (manager *RepoManager) EnsureReposPresent(taskClassesRequired []string) (err error) {
-	reposRequired := make(map[Repo]bool)
+	reposRequired := make(map[string]bool)
 	for _, taskClass := range taskClassesRequired {
 		var newRepo *Repo
 		newRepo, err = NewRepo(taskClass)
 		if err != nil {
 			return
 		}
-		reposRequired[*newRepo] = true
+		reposRequired[newRepo.GetIdentifier()] = true
 	}
 
-	// Make sure that the relevant repos are present and checked out on the expected revision
-	for repo  := range reposRequired {
-		existingRepo, ok := manager.repoList[repo.GetIdentifier()]
+	// Make sure that the relevant repos are present. Task classes are read at
+	// the hash they were resolved against (see Repo.ReadFileAtHash), so the
+	// checked out revision is left alone.
+	for repoIdentifier := range reposRequired {
+		_, ok := manager.repoList[repoIdentifier]
 		if !ok {
-			err = manager.AddRepo(repo.GetIdentifier())
+			err = manager.AddRepo(repoIdentifier)
 			if err != nil {
 				return
 			}
-		} else {
-			if existingRepo.Revision != repo.Revision {
-				err = existingRepo.checkoutRevision(repo.Revision)
-				if err != nil {
-					return
-				}
-			}
 		}
 	}
 
@@ -422,4 +431,103 @@ func (manager *RepoManager) GetWorkflowTemplates() (map[string][]string, int, er
 	}
 
 	return templateList, numTemplates, nil
-}
\ No newline at end of file
+}
+
+// ReloadRepos applies the repository setup found in the configuration backend:
+// any listed repository which isn't known yet is cloned, and the default repo
+// is switched if it was changed in the backend. Repositories added by other
+// means are left alone.
+func (manager *RepoManager) ReloadRepos() (addedRepos []string, newDefaultRepo string, err error) {
+	var defaultRepoPath string
+	var repoList []string
+	defaultRepoPath, repoList, err = manager.cService.RefreshRepositories()
+	if err != nil {
+		return
+	}
+
+	for _, repoPath := range repoList {
+		var repo *Repo
+		repo, err = NewRepo(repoPath)
+		if err != nil {
+			err = errors.New("cannot parse repository " + repoPath + ": " + err.Error())
+			return
+		}
+		if _, exists := manager.GetRepos()[repo.GetIdentifier()]; exists {
+			continue
+		}
+		err = manager.AddRepo(repoPath)
+		if err != nil {
+			err = errors.New("cannot add repository " + repoPath + ": " + err.Error())
+			return
+		}
+		addedRepos = append(addedRepos, repo.GetIdentifier())
+	}
+
+	if len(defaultRepoPath) == 0 {
+		return
+	}
+	var defaultRepo *Repo
+	defaultRepo, err = NewRepo(defaultRepoPath)
+	if err != nil {
+		err = errors.New("cannot parse default repository " + defaultRepoPath + ": " + err.Error())
+		return
+	}
+	if _, exists := manager.GetRepos()[defaultRepo.GetIdentifier()]; !exists {
+		err = manager.AddRepo(defaultRepoPath)
+		if err != nil {
+			err = errors.New("cannot add default repository " + defaultRepoPath + ": " + err.Error())
+			return
+		}
+		addedRepos = append(addedRepos, defaultRepo.GetIdentifier())
+	}
+
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+	repo := manager.repoList[defaultRepo.GetIdentifier()]
+	if repo != nil && repo != manager.defaultRepo {
+		manager.setDefaultRepo(repo)
+		newDefaultRepo = repo.GetIdentifier()
+	}
+	return
+}
+
+// ValidateRepo checks the task classes and workflow templates of a known repo
+// against their schema, and returns one message for each problem found.
+func (manager *RepoManager) ValidateRepo(repoPath string) (problems []string) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	utils.EnsureTrailingSlash(&repoPath)
+
+	repo, err := NewRepo(repoPath)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	repo = manager.repoList[repo.GetIdentifier()]
+	if repo == nil {
+		return []string{"repo not found: " + repoPath}
+	}
+	return repo.validate()
+}
+
+// ValidateRepos is like ValidateRepo, but for all known repos.
+func (manager *RepoManager) ValidateRepos() (problems []string) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	for _, repoName := range manager.GetOrderedRepolistKeys() {
+		problems = append(problems, manager.repoList[repoName].validate()...)
+	}
+	return
+}
+
+func (manager *RepoManager) ValidateRepoByIndex(index int) (problems []string) {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	keys := manager.GetOrderedRepolistKeys()
+	if index < 0 || index >= len(keys) {
+		return []string{"repo not found for index: " + strconv.Itoa(index)}
+	}
+	return manager.repoList[keys[index]].validate()
+}
@@ -38,9 +38,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/AliceO2Group/Control/common/controlmode"
 	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/core/controlcommands"
 	"github.com/AliceO2Group/Control/core/environment"
+	"github.com/AliceO2Group/Control/core/secrets"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/constraint"
 	"github.com/AliceO2Group/Control/executor/protos"
@@ -271,6 +273,29 @@ func incomingMessageHandler(state *internalState, fidStore store.Singleton) even
 					Error("cannot handle incoming device event")
 			}
 
+		case "DeviceStateChanged":
+			var incomingEvent event.DeviceStateChanged
+			err = json.Unmarshal(data, &incomingEvent)
+			if err != nil {
+				return
+			}
+			log.WithPrefix("scheduler").
+				WithFields(logrus.Fields{
+					"taskId": incomingEvent.Origin.TaskId.Value,
+					"state": incomingEvent.State,
+					"trigger": incomingEvent.Trigger.String(),
+				}).
+				Warning("task changed state on its own")
+			go state.taskman.UpdateTaskState(incomingEvent.Origin.TaskId.Value, incomingEvent.State)
+
+		case "TaskRestarted":
+			var incomingEvent event.TaskRestarted
+			err = json.Unmarshal(data, &incomingEvent)
+			if err != nil {
+				return
+			}
+			go handleTaskRestarted(state, &incomingEvent)
+
 		case "MesosCommandResponse":
 			var incomingCommand struct {
 				CommandName string `json:"name"`
@@ -342,6 +367,72 @@ func handleDeviceEvent(state *internalState, evt event.DeviceEvent) {
 	}
 }
 
+// handleTaskRestarted brings a task which was relaunched by its executor back
+// to the state of its environment, by replaying the last CONFIGURE and, if
+// needed, START transitions.
+func handleTaskRestarted(state *internalState, evt *event.TaskRestarted) {
+	if evt == nil {
+		log.WithPrefix("scheduler").Error("cannot handle null TaskRestarted event")
+		return
+	}
+
+	taskId := evt.GetOrigin().TaskId
+	log.WithPrefix("scheduler").
+		WithFields(logrus.Fields{
+			"taskId": taskId.Value,
+			"restartCount": evt.RestartCount,
+			"state": evt.State,
+		}).
+		Info("task restarted by executor")
+
+	// The new process starts from scratch, so whatever we knew about the
+	// task state before the crash is no longer valid.
+	state.taskman.UpdateTaskState(taskId.Value, evt.State)
+
+	t := state.taskman.GetTask(taskId.Value)
+	if t == nil {
+		log.WithPrefix("scheduler").Error("cannot find task for TaskRestarted event")
+		return
+	}
+	env, err := state.environments.Environment(t.GetEnvironmentId().UUID())
+	if err != nil {
+		log.WithPrefix("scheduler").WithError(err).Error("cannot find environment for TaskRestarted event")
+		return
+	}
+
+	envState := env.CurrentState()
+	if envState != "CONFIGURED" && envState != "RUNNING" {
+		return
+	}
+
+	err = state.taskman.ReplayConfiguration(taskId.Value)
+	if err != nil {
+		log.WithPrefix("scheduler").
+			WithField("taskId", taskId.Value).
+			WithError(err).
+			Error("cannot replay configuration to restarted task")
+		return
+	}
+
+	if envState == "RUNNING" {
+		err = state.taskman.TransitionTasks(
+			task.Tasks{t},
+			task.CONFIGURED.String(),
+			task.START.String(),
+			task.RUNNING.String(),
+			controlcommands.PropertyMap{
+				"runNumber": strconv.FormatUint(uint64(env.GetCurrentRunNumber()), 10),
+			},
+		)
+		if err != nil {
+			log.WithPrefix("scheduler").
+				WithField("taskId", taskId.Value).
+				WithError(err).
+				Error("cannot start restarted task")
+		}
+	}
+}
+
 // Handler for Event_OFFERS
 func resourceOffers(state *internalState, fidStore store.Singleton) events.HandlerFunc {
 	return func(ctx context.Context, e *scheduler.Event) error {
@@ -489,20 +580,23 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 
 					// Point of no return, we start subtracting resources
 
-					bindMap := make(map[string]uint64)
+					// Each sub-socket of an inbound channel gets its own port
+					bindMap := make(map[string][]uint64)
 					for _, ch := range wants.BindPorts {
-						availPorts, ok := resources.Ports(remainingResources...)
-						if !ok {
-							continue FOR_DESCRIPTORS
+						for i := 0; i < ch.NumSockets; i++ {
+							availPorts, ok := resources.Ports(remainingResources...)
+							if !ok {
+								continue FOR_DESCRIPTORS
+							}
+							// TODO: this can be optimized by excluding the base range outside the loop
+							availPorts = availPorts.Remove(mesos.Value_Range{Begin: 0, End: 8999})
+							port := availPorts.Min()
+							builder := resources.Build().
+								Name(resources.Name("ports")).
+								Ranges(resources.BuildRanges().Span(port, port).Ranges)
+							remainingResources.Subtract(builder.Resource)
+							bindMap[ch.Name] = append(bindMap[ch.Name], port)
 						}
-						// TODO: this can be optimized by excluding the base range outside the loop
-						availPorts = availPorts.Remove(mesos.Value_Range{Begin: 0, End: 8999})
-						port := availPorts.Min()
-						builder := resources.Build().
-							Name(resources.Name("ports")).
-							Ranges(resources.BuildRanges().Span(port, port).Ranges)
-						remainingResources.Subtract(builder.Resource)
-						bindMap[ch.Name] = port
 					}
 
 					agentForCache := task.AgentCacheInfo{
@@ -544,15 +638,34 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 						Ranges(resources.BuildRanges().Span(controlPort, controlPort).Ranges)
 					remainingResources.Subtract(builder.Resource)
 
-					// Append control port to arguments
+					// Append control port to arguments and environment
 					// For the control port parameter and/or environment variable, see occ/OccGlobals.h
-					cmd.Arguments = append(cmd.Arguments, "--control-port", strconv.FormatUint(controlPort, 10))
+					// Tasks in basic or hook control mode have no OCC endpoint, so they wouldn't know
+					// what to do with it.
+					if cmd.ControlMode != controlmode.BASIC && cmd.ControlMode != controlmode.HOOK {
+						cmd.Arguments = append(cmd.Arguments, "--control-port", strconv.FormatUint(controlPort, 10))
+						cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d", "OCC_CONTROL_PORT", controlPort))
+					}
 					cmd.ControlPort = controlPort
-					cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d", "OCC_CONTROL_PORT", controlPort))
 					cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", "O2_ROLE", offer.Hostname))
 
 					runCommand := *cmd
 
+					// Secret references are resolved only in the copy sent to the executor
+					runCommand.Env, err = secrets.ResolveEnv(cmd.Env)
+					if err == nil {
+						runCommand.Arguments, err = secrets.ResolveArguments(cmd.Arguments)
+					}
+					if err != nil {
+						log.WithPrefix("scheduler").
+							WithFields(logrus.Fields{
+								"error": err.Error(),
+								"task":  taskPtr.GetName(),
+							}).
+							Error("cannot resolve secrets for task command")
+						continue FOR_DESCRIPTORS
+					}
+
 					// Serialize the actual command to be passed to the executor
 					var jsonCommand []byte
 					jsonCommand, err = json.Marshal(&runCommand)
@@ -579,8 +692,10 @@ func resourceOffers(state *internalState, fidStore store.Singleton) events.Handl
 					for _, rng := range wants.StaticPorts {
 						portsBuilder = portsBuilder.Span(rng.Begin, rng.End)
 					}
-					for _, port := range bindMap {
-						portsBuilder = portsBuilder.Span(port, port)
+					for _, ports := range bindMap {
+						for _, port := range ports {
+							portsBuilder = portsBuilder.Span(port, port)
+						}
 					}
 					portsBuilder = portsBuilder.Span(controlPort, controlPort)
 
@@ -0,0 +1,324 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package schema validates task class and workflow template documents
+// before they are unmarshaled, so that all mistakes in a document are
+// reported at once, each with its path and line number, instead of the
+// first one failing late or being silently replaced by a default.
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+type Kind int
+
+const (
+	K_Any Kind = iota
+	K_String   // any scalar
+	K_Bool
+	K_Int
+	K_Uint
+	K_Float
+	K_Duration
+	K_Map
+	K_List
+)
+
+func (k Kind) String() string {
+	switch k {
+	case K_Any:
+		return "any value"
+	case K_String:
+		return "string"
+	case K_Bool:
+		return "boolean"
+	case K_Int:
+		return "integer"
+	case K_Uint:
+		return "non-negative integer"
+	case K_Float:
+		return "number"
+	case K_Duration:
+		return "duration"
+	case K_Map:
+		return "map"
+	case K_List:
+		return "list"
+	}
+	return "unknown"
+}
+
+// Node describes the expected shape of a YAML node.
+// For a map, Fields holds the schema of the known keys, and Values the
+// schema of any other key; if Values is nil, other keys are an error.
+// For a list, Items holds the schema of each item.
+// Enum restricts scalars to a set of case insensitive values, and Check is
+// run on the node after all other validation succeeded.
+type Node struct {
+	Kind     Kind
+	Required bool
+	Fields   map[string]*Node
+	Values   *Node
+	Items    *Node
+	Enum     []string
+	Check    func(node *yaml.Node) error
+}
+
+// Error is a single validation failure.
+type Error struct {
+	File    string
+	Path    string
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e Error) Error() string {
+	location := fmt.Sprintf("line %d, column %d", e.Line, e.Column)
+	if len(e.File) > 0 {
+		location = fmt.Sprintf("%s:%d:%d", e.File, e.Line, e.Column)
+	}
+	return fmt.Sprintf("%s: %s: %s", location, e.Path, e.Message)
+}
+
+// Errors holds all the validation failures of a document, in document order.
+type Errors []Error
+
+func (errs Errors) Error() string {
+	lines := make([]string, len(errs))
+	for i, e := range errs {
+		lines[i] = e.Error()
+	}
+	return fmt.Sprintf("%d validation error(s):\n%s", len(errs), strings.Join(lines, "\n"))
+}
+
+// Validate checks yamlDoc against schema. It returns nil if the document is
+// valid, Errors if it isn't, or a plain error if it isn't even valid YAML.
+// fileName is only used in error messages.
+func Validate(fileName string, yamlDoc []byte, schema *Node) error {
+	var doc yaml.Node
+	err := yaml.Unmarshal(yamlDoc, &doc)
+	if err != nil {
+		if len(fileName) > 0 {
+			return errors.New(fmt.Sprintf("%s: %s", fileName, err.Error()))
+		}
+		return err
+	}
+
+	v := validator{fileName: fileName}
+	if len(doc.Content) == 0 {
+		v.addError(&doc, "", "empty document")
+	} else {
+		v.validate(doc.Content[0], "", schema)
+	}
+
+	if len(v.errs) == 0 {
+		return nil
+	}
+	sort.SliceStable(v.errs, func(i, j int) bool {
+		if v.errs[i].Line == v.errs[j].Line {
+			return v.errs[i].Column < v.errs[j].Column
+		}
+		return v.errs[i].Line < v.errs[j].Line
+	})
+	return v.errs
+}
+
+type validator struct {
+	fileName string
+	errs     Errors
+}
+
+func (v *validator) addError(node *yaml.Node, path string, message string) {
+	if len(path) == 0 {
+		path = "."
+	}
+	v.errs = append(v.errs, Error{
+		File:    v.fileName,
+		Path:    path,
+		Line:    node.Line,
+		Column:  node.Column,
+		Message: message,
+	})
+}
+
+func isTemplated(value string) bool {
+	// Resolved later, when the workflow is instantiated
+	return strings.Contains(value, "{{")
+}
+
+func (v *validator) validate(node *yaml.Node, path string, schema *Node) {
+	if node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	if schema == nil || schema.Kind == K_Any {
+		return
+	}
+
+	isNull := node.Kind == yaml.ScalarNode && node.Tag == "!!null"
+	if isNull {
+		if schema.Required {
+			v.addError(node, path, fmt.Sprintf("expected %s, got empty value", schema.Kind.String()))
+		}
+		return
+	}
+
+	switch schema.Kind {
+	case K_Map:
+		if node.Kind != yaml.MappingNode {
+			v.addError(node, path, fmt.Sprintf("expected map, got %s", describe(node)))
+			return
+		}
+		if !v.validateMap(node, path, schema) {
+			return
+		}
+	case K_List:
+		if node.Kind != yaml.SequenceNode {
+			v.addError(node, path, fmt.Sprintf("expected list, got %s", describe(node)))
+			return
+		}
+		errCount := len(v.errs)
+		for i, item := range node.Content {
+			v.validate(item, fmt.Sprintf("%s[%d]", path, i), schema.Items)
+		}
+		if len(v.errs) > errCount {
+			return
+		}
+	default:
+		if node.Kind != yaml.ScalarNode {
+			v.addError(node, path, fmt.Sprintf("expected %s, got %s", schema.Kind.String(), describe(node)))
+			return
+		}
+		if isTemplated(node.Value) {
+			return
+		}
+		if err := checkScalar(node.Value, schema); err != nil {
+			v.addError(node, path, err.Error())
+			return
+		}
+	}
+
+	if schema.Check != nil {
+		if err := schema.Check(node); err != nil {
+			v.addError(node, path, err.Error())
+		}
+	}
+}
+
+func (v *validator) validateMap(node *yaml.Node, path string, schema *Node) (ok bool) {
+	errCount := len(v.errs)
+	present := make(map[string]bool)
+	for i := 0; i + 1 < len(node.Content); i += 2 {
+		keyNode, valueNode := node.Content[i], node.Content[i+1]
+		key := keyNode.Value
+		if key == "<<" { // merge key, the merged values are not checked
+			continue
+		}
+		present[key] = true
+
+		fieldPath := key
+		if len(path) > 0 {
+			fieldPath = path + "." + key
+		}
+		fieldSchema, known := schema.Fields[key]
+		if !known {
+			fieldSchema = schema.Values
+		}
+		if fieldSchema == nil {
+			v.addError(keyNode, fieldPath, fmt.Sprintf("unknown field %s", key))
+			continue
+		}
+		v.validate(valueNode, fieldPath, fieldSchema)
+	}
+
+	fieldNames := make([]string, 0, len(schema.Fields))
+	for name := range schema.Fields {
+		fieldNames = append(fieldNames, name)
+	}
+	sort.Strings(fieldNames)
+	for _, name := range fieldNames {
+		if schema.Fields[name].Required && !present[name] {
+			v.addError(node, path, fmt.Sprintf("missing required field %s", name))
+		}
+	}
+	return len(v.errs) == errCount
+}
+
+func checkScalar(value string, schema *Node) (err error) {
+	trimmed := strings.TrimSpace(value)
+	switch schema.Kind {
+	case K_Bool:
+		switch strings.ToLower(trimmed) {
+		case "true", "false":
+		default:
+			err = errors.New(fmt.Sprintf("expected boolean (true or false), got %q", value))
+		}
+	case K_Int:
+		if _, parseErr := strconv.Atoi(trimmed); parseErr != nil {
+			err = errors.New(fmt.Sprintf("expected integer, got %q", value))
+		}
+	case K_Uint:
+		if _, parseErr := strconv.ParseUint(trimmed, 10, 32); parseErr != nil {
+			err = errors.New(fmt.Sprintf("expected non-negative integer, got %q", value))
+		}
+	case K_Float:
+		if _, parseErr := strconv.ParseFloat(trimmed, 64); parseErr != nil {
+			err = errors.New(fmt.Sprintf("expected number, got %q", value))
+		}
+	case K_Duration:
+		if _, parseErr := time.ParseDuration(trimmed); parseErr != nil {
+			err = errors.New(fmt.Sprintf("expected duration such as 30s or 1m30s, got %q", value))
+		}
+	}
+	if err != nil || len(schema.Enum) == 0 {
+		return
+	}
+
+	for _, allowed := range schema.Enum {
+		if strings.EqualFold(trimmed, allowed) {
+			return
+		}
+	}
+	return errors.New(fmt.Sprintf("invalid value %q, expected one of: %s", value, strings.Join(schema.Enum, ", ")))
+}
+
+func describe(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "map"
+	case yaml.SequenceNode:
+		return "list"
+	case yaml.ScalarNode:
+		return fmt.Sprintf("%q", node.Value)
+	}
+	return "unexpected node"
+}
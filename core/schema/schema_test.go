@@ -0,0 +1,323 @@
+package schema_test
+
+import (
+	"github.com/AliceO2Group/Control/core/schema"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const validTaskClass = `name: fairmq-ex-1-n-1-sampler
+control:
+  mode: fairmq
+wants:
+  cpu: 0.1
+  memory: 128
+  ports: "8080-8083,9091"
+bind:
+  - name: data1
+    type: push
+    sndBufSize: 1000
+    rcvBufSize: "1000"
+    rateLogging: 0
+properties:
+  severity: trace
+  color: false
+defaults:
+  shm_segment_size: "1000000000"
+restart:
+  policy: on-failure
+  maxRetries: 5
+  backoff: 2s
+command:
+  env: []
+  shell: true
+  arguments:
+    - "--id"
+    - sampler
+  value: fairmq-ex-1-n-1-sampler
+`
+
+const validWorkflow = `name: readout
+vars:
+  n_flps: 2
+roles:
+  - name: flp
+    constraints:
+      - attribute: machine_id
+        value: flp-1
+    roles:
+      - name: readout
+        task:
+          load: readout
+        connect:
+          - name: data
+            type: push
+            target: "{{ parent }}.stfb:data"
+      - name: "stfb-{{ it }}"
+        for:
+          begin: 0
+          end: "{{ n_flps }}"
+          var: it
+        task:
+          load: stfb
+`
+
+func validate(validateFunc func(string, []byte) error, doc string) schema.Errors {
+	err := validateFunc("test.yaml", []byte(doc))
+	if err == nil {
+		return nil
+	}
+	errs, ok := err.(schema.Errors)
+	Expect(ok).To(BeTrue(), "expected validation errors, got %s", err.Error())
+	return errs
+}
+
+var _ = Describe("schema", func() {
+	Describe("task classes", func() {
+		It("should accept a valid task class", func() {
+			Expect(schema.ValidateTaskClass("test.yaml", []byte(validTaskClass))).To(Succeed())
+		})
+
+		It("should report every error with its path and line", func() {
+			errs := validate(schema.ValidateTaskClass, `name: broken
+control:
+  mode: fairmqq
+wants:
+  cpu: one
+  memory: -5
+  ports: "9000-8000"
+bind:
+  - name: data1
+    type: pushh
+    sndBufSize: lots
+restart:
+  policy: sometimes
+command:
+  shell: yes please
+  valeu: typo
+`)
+			Expect(errs).To(HaveLen(10))
+			Expect(errs[0].Path).To(Equal("control.mode"))
+			Expect(errs[0].Line).To(Equal(3))
+			Expect(errs[1].Path).To(Equal("wants.cpu"))
+			Expect(errs[1].Line).To(Equal(5))
+			Expect(errs[2].Path).To(Equal("wants.memory"))
+			Expect(errs[3].Path).To(Equal("wants.ports"))
+			Expect(errs[4].Path).To(Equal("bind[0].type"))
+			Expect(errs[4].Line).To(Equal(10))
+			Expect(errs[5].Path).To(Equal("bind[0].sndBufSize"))
+			Expect(errs[6].Path).To(Equal("restart.policy"))
+			Expect(errs[7].Path).To(Equal("command"))
+			Expect(errs[7].Message).To(ContainSubstring("missing required field value"))
+			Expect(errs[8].Path).To(Equal("command.shell"))
+			Expect(errs[8].Line).To(Equal(15))
+			Expect(errs[9].Path).To(Equal("command.valeu"))
+			Expect(errs[9].Message).To(Equal("unknown field valeu"))
+		})
+
+		It("should reject unknown fields", func() {
+			errs := validate(schema.ValidateTaskClass, "name: foo\nwnats:\n  cpu: 1\n")
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Path).To(Equal("wnats"))
+			Expect(errs[0].Line).To(Equal(2))
+			Expect(errs[0].Error()).To(Equal("test.yaml:2:1: wnats: unknown field wnats"))
+		})
+
+		It("should reject hooks for unknown events", func() {
+			errs := validate(schema.ValidateTaskClass, `name: foo
+control:
+  mode: hook
+  hooks:
+    commands:
+      START: "echo start"
+      LAUNCH: "echo launch"
+    timeout: 10s
+`)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Path).To(Equal("control.hooks.commands"))
+			Expect(errs[0].Message).To(ContainSubstring("LAUNCH"))
+		})
+
+		It("should check channel types, transports and sub-sockets", func() {
+			errs := validate(schema.ValidateTaskClass, `name: foo
+bind:
+  - name: requests
+    type: router
+    transport: shmem
+    sndKernelSize: 65536
+    numSockets: 2
+  - name: broken
+    type: spub
+    transport: tcp
+    rcvKernelSize: -1
+    numSockets: 0
+`)
+			Expect(errs).To(HaveLen(4))
+			Expect(errs[0].Path).To(Equal("bind[1].type"))
+			Expect(errs[1].Path).To(Equal("bind[1].transport"))
+			Expect(errs[2].Path).To(Equal("bind[1].rcvKernelSize"))
+			Expect(errs[3].Path).To(Equal("bind[1].numSockets"))
+		})
+
+		It("should report YAML syntax errors as such", func() {
+			err := schema.ValidateTaskClass("test.yaml", []byte("name: [foo\n"))
+			Expect(err).To(HaveOccurred())
+			_, ok := err.(schema.Errors)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("workflows", func() {
+		It("should accept a valid workflow, including templated values", func() {
+			Expect(schema.ValidateWorkflow("test.yaml", []byte(validWorkflow))).To(Succeed())
+		})
+
+		It("should check roles recursively", func() {
+			errs := validate(schema.ValidateWorkflow, `name: broken
+roles:
+  - name: flp
+    roles:
+      - name: readout
+        task:
+          load: readout
+        roles: []
+      - task:
+          load: stfb
+        connect:
+          - name: data
+            type: push
+      - name: qc
+        for:
+          begin: zero
+          end: 2
+          var: it
+        task:
+          load: qc
+`)
+			Expect(errs).To(HaveLen(4))
+			Expect(errs[0].Path).To(Equal("roles[0].roles[0]"))
+			Expect(errs[0].Message).To(ContainSubstring("both roles and task"))
+			Expect(errs[1].Path).To(Equal("roles[0].roles[1]"))
+			Expect(errs[1].Message).To(ContainSubstring("missing required field name"))
+			Expect(errs[1].Line).To(Equal(9))
+			Expect(errs[2].Path).To(Equal("roles[0].roles[1].connect[0]"))
+			Expect(errs[2].Message).To(ContainSubstring("missing required field target"))
+			Expect(errs[3].Path).To(Equal("roles[0].roles[2].for.begin"))
+			Expect(errs[3].Line).To(Equal(16))
+		})
+
+		It("should accept include roles", func() {
+			errs := validate(schema.ValidateWorkflow, `name: flp
+roles:
+  - name: readout
+    include: github.com/AliceO2Group/ControlWorkflows/workflows/readout-stfb@v0.1.0
+    vars:
+      detector: tpc
+  - name: qc
+    include: qc
+    task:
+      load: qc
+  - name: dpl
+    include: dpl
+    for:
+      begin: 0
+      end: 2
+      var: it
+`)
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Path).To(Equal("roles[1]"))
+			Expect(errs[0].Message).To(ContainSubstring("both task and include"))
+			Expect(errs[1].Path).To(Equal("roles[2]"))
+			Expect(errs[1].Message).To(ContainSubstring("cannot have a for iterator"))
+		})
+
+		It("should accept iterators over values, configuration and agents", func() {
+			errs := validate(schema.ValidateWorkflow, `name: flps
+roles:
+  - name: "readout-{{ .host }}"
+    for:
+      values: [flp001, flp002]
+      var: host
+    task:
+      load: readout
+  - name: "stfb-{{ .flp }}"
+    for:
+      configPrefix: o2/hardware/flps
+      var: flp
+    task:
+      load: stfb
+  - name: "qc-{{ .host }}"
+    for:
+      agents:
+        - attribute: role
+          value: qc
+      var: host
+    task:
+      load: qc
+  - name: "dpl-{{ .it }}"
+    for:
+      begin: 0
+      end: 1
+      values: [a]
+      var: it
+    task:
+      load: dpl
+  - name: "half-{{ .it }}"
+    for:
+      begin: 0
+      var: it
+    task:
+      load: half
+`)
+			Expect(errs).To(HaveLen(2))
+			Expect(errs[0].Path).To(Equal("roles[3].for"))
+			Expect(errs[0].Message).To(ContainSubstring("exactly one of"))
+			Expect(errs[1].Path).To(Equal("roles[4].for"))
+			Expect(errs[1].Message).To(ContainSubstring("both begin and end"))
+		})
+
+		It("should accept enabled expressions on roles", func() {
+			errs := validate(schema.ValidateWorkflow, `name: flp
+roles:
+  - name: qc
+    enabled: "{{ .qc_enabled }}"
+    task:
+      load: qc
+  - name: readout
+    enabled: true
+    task:
+      load: readout
+  - name: stfb
+    enabled:
+      - true
+    task:
+      load: stfb
+`)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Path).To(Equal("roles[2].enabled"))
+		})
+
+		It("should require templated names for iterator roles", func() {
+			errs := validate(schema.ValidateWorkflow, `name: flps
+roles:
+  - name: readout
+    for:
+      begin: 0
+      end: 3
+      var: it
+    task:
+      load: readout
+`)
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Path).To(Equal("roles[0]"))
+			Expect(errs[0].Message).To(ContainSubstring("must be a template"))
+		})
+
+		It("should require roles at the top level", func() {
+			errs := validate(schema.ValidateWorkflow, "name: empty\n")
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Path).To(Equal("."))
+			Expect(errs[0].Message).To(ContainSubstring("missing required field roles"))
+		})
+	})
+})
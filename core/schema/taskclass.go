@@ -0,0 +1,161 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/core/task/channel"
+	"gopkg.in/yaml.v3"
+)
+
+func checkChannelType(node *yaml.Node) error {
+	var ct channel.ChannelType
+	return ct.UnmarshalText([]byte(node.Value))
+}
+
+func checkChannelTransport(node *yaml.Node) error {
+	var tt channel.TransportType
+	return tt.UnmarshalText([]byte(node.Value))
+}
+
+func checkPositive(node *yaml.Node) error {
+	value, err := strconv.ParseFloat(strings.TrimSpace(node.Value), 64)
+	if err == nil && value <= 0 {
+		return errors.New(fmt.Sprintf("expected positive number, got %s", node.Value))
+	}
+	return nil
+}
+
+func checkHookEvents(node *yaml.Node) error {
+	var invalid []string
+	for i := 0; i < len(node.Content); i += 2 {
+		if !common.IsHookEvent(node.Content[i].Value) {
+			invalid = append(invalid, node.Content[i].Value)
+		}
+	}
+	if len(invalid) > 0 {
+		return errors.New(fmt.Sprintf("invalid hook for unknown transition event(s) %s", strings.Join(invalid, ", ")))
+	}
+	return nil
+}
+
+// checkPortRanges accepts a comma separated list of ports and port ranges,
+// such as "8080-8083,9091-10100".
+func checkPortRanges(node *yaml.Node) error {
+	if len(strings.TrimSpace(node.Value)) == 0 {
+		return nil
+	}
+	for _, portRange := range strings.Split(node.Value, ",") {
+		rangeSplit := strings.Split(strings.TrimSpace(portRange), "-")
+		if len(rangeSplit) > 2 {
+			return errors.New(fmt.Sprintf("bad port range %q", portRange))
+		}
+		var bounds []uint64
+		for _, portStr := range rangeSplit {
+			port, err := strconv.ParseUint(strings.TrimSpace(portStr), 10, 16)
+			if err != nil || port == 0 {
+				return errors.New(fmt.Sprintf("bad port %q in port range %q", portStr, strings.TrimSpace(portRange)))
+			}
+			bounds = append(bounds, port)
+		}
+		if len(bounds) == 2 && bounds[0] > bounds[1] {
+			return errors.New(fmt.Sprintf("bad port range %q, begin is greater than end", strings.TrimSpace(portRange)))
+		}
+	}
+	return nil
+}
+
+func checkNotNegative(node *yaml.Node) error {
+	value, err := strconv.ParseFloat(strings.TrimSpace(node.Value), 64)
+	if err == nil && value < 0 {
+		return errors.New(fmt.Sprintf("expected non-negative number, got %s", node.Value))
+	}
+	return nil
+}
+
+func channelFields() map[string]*Node {
+	return map[string]*Node{
+		"name":          {Kind: K_String, Required: true},
+		"type":          {Kind: K_String, Required: true, Check: checkChannelType},
+		"sndBufSize":    {Kind: K_Int},
+		"rcvBufSize":    {Kind: K_Int},
+		"sndKernelSize": {Kind: K_Uint},
+		"rcvKernelSize": {Kind: K_Uint},
+		"rateLogging":   {Kind: K_Int},
+		"transport":     {Kind: K_String, Check: checkChannelTransport},
+		"numSockets":    {Kind: K_Uint, Check: checkPositive},
+	}
+}
+
+func constraintsNode() *Node {
+	return &Node{Kind: K_List, Items: &Node{Kind: K_Map, Fields: map[string]*Node{
+		"attribute": {Kind: K_String, Required: true},
+		"value":     {Kind: K_String},
+	}}}
+}
+
+// TaskClass is the schema of a task class document, i.e. a file in the
+// tasks directory of a configuration repository.
+var TaskClass = &Node{Kind: K_Map, Fields: map[string]*Node{
+	"name": {Kind: K_String, Required: true},
+	"control": {Kind: K_Map, Fields: map[string]*Node{
+		"mode": {Kind: K_String, Enum: []string{"direct", "fairmq", "basic", "hook"}},
+		"hooks": {Kind: K_Map, Fields: map[string]*Node{
+			"commands": {Kind: K_Map, Values: &Node{Kind: K_String}, Check: checkHookEvents},
+			"timeout":  {Kind: K_Duration},
+		}},
+	}},
+	"command": {Kind: K_Map, Fields: map[string]*Node{
+		"env":       {Kind: K_List, Items: &Node{Kind: K_String}},
+		"shell":     {Kind: K_Bool},
+		"value":     {Kind: K_String, Required: true},
+		"arguments": {Kind: K_List, Items: &Node{Kind: K_String}},
+		"user":      {Kind: K_String},
+	}},
+	"restart": {Kind: K_Map, Fields: map[string]*Node{
+		"policy":     {Kind: K_String, Enum: []string{"never", "on-failure", "onfailure", "on_failure"}},
+		"maxRetries": {Kind: K_Uint},
+		"backoff":    {Kind: K_Duration},
+	}},
+	"wants": {Kind: K_Map, Fields: map[string]*Node{
+		"cpu":    {Kind: K_Float, Check: checkNotNegative},
+		"memory": {Kind: K_Float, Check: checkNotNegative},
+		"ports":  {Kind: K_String, Check: checkPortRanges},
+	}},
+	"bind":        {Kind: K_List, Items: &Node{Kind: K_Map, Fields: channelFields()}},
+	"properties":  {Kind: K_Map, Values: &Node{Kind: K_String}},
+	"defaults":    {Kind: K_Map, Values: &Node{Kind: K_String}},
+	"constraints": constraintsNode(),
+}}
+
+// ValidateTaskClass checks a task class document, see Validate.
+func ValidateTaskClass(fileName string, yamlDoc []byte) error {
+	return Validate(fileName, yamlDoc, TaskClass)
+}
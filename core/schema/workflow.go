@@ -0,0 +1,138 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+func hasField(node *yaml.Node, key string) bool {
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return true
+		}
+	}
+	return false
+}
+
+func fieldValue(node *yaml.Node, key string) (value string, ok bool) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1].Value, true
+		}
+	}
+	return "", false
+}
+
+// checkRoleKind makes sure a role is either an aggregator, a task role or an
+// include, the same way the workflow loader tells them apart.
+func checkRoleKind(node *yaml.Node) error {
+	kinds := make([]string, 0)
+	for _, kind := range []string{"roles", "task", "include"} {
+		if hasField(node, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	switch {
+	case len(kinds) > 1:
+		return errors.New(fmt.Sprintf("role cannot have both %s and %s", kinds[0], kinds[1]))
+	case len(kinds) == 0:
+		return errors.New("role must have either roles, task or include")
+	case kinds[0] == "include" && hasField(node, "for"):
+		return errors.New("include role cannot have a for iterator")
+	}
+	// All the roles generated by an iterator would have the same name
+	if name, ok := fieldValue(node, "name"); ok && hasField(node, "for") && !isTemplated(name) {
+		return errors.New(fmt.Sprintf("name %s of iterator role must be a template", name))
+	}
+	return nil
+}
+
+// checkIteratorSource makes sure an iterator loops over exactly one thing:
+// a begin/end range, a list of values, a configuration prefix or agents.
+func checkIteratorSource(node *yaml.Node) error {
+	hasBegin, hasEnd := hasField(node, "begin"), hasField(node, "end")
+	if hasBegin != hasEnd {
+		return errors.New("iterator range needs both begin and end")
+	}
+	sources := 0
+	for _, present := range []bool{hasBegin, hasField(node, "values"), hasField(node, "configPrefix"), hasField(node, "agents")} {
+		if present {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return errors.New("iterator needs exactly one of begin/end, values, configPrefix or agents")
+	}
+	return nil
+}
+
+func roleFields() map[string]*Node {
+	outboundFields := channelFields()
+	outboundFields["target"] = &Node{Kind: K_String, Required: true}
+
+	return map[string]*Node{
+		"name":        {Kind: K_String, Required: true},
+		"vars":        {Kind: K_Map, Values: &Node{Kind: K_String}},
+		"connect":     {Kind: K_List, Items: &Node{Kind: K_Map, Fields: outboundFields}},
+		"constraints": constraintsNode(),
+		"enabled":     {Kind: K_String},
+	}
+}
+
+// Workflow is the schema of a workflow template document, i.e. a file in the
+// workflows directory of a configuration repository.
+var Workflow = newWorkflowSchema()
+
+func newWorkflowSchema() *Node {
+	role := &Node{Kind: K_Map, Fields: roleFields(), Check: checkRoleKind}
+	role.Fields["roles"] = &Node{Kind: K_List, Items: role}
+	role.Fields["task"] = &Node{Kind: K_Map, Fields: map[string]*Node{
+		"load": {Kind: K_String, Required: true},
+	}}
+	role.Fields["include"] = &Node{Kind: K_String}
+	role.Fields["for"] = &Node{Kind: K_Map, Check: checkIteratorSource, Fields: map[string]*Node{
+		"begin":        {Kind: K_Int},
+		"end":          {Kind: K_Int},
+		"values":       {Kind: K_List, Items: &Node{Kind: K_String}},
+		"configPrefix": {Kind: K_String},
+		"agents":       constraintsNode(),
+		"var":          {Kind: K_String, Required: true},
+	}}
+
+	// The root of a workflow is always an aggregator
+	root := &Node{Kind: K_Map, Fields: roleFields()}
+	delete(root.Fields, "enabled")
+	root.Fields["roles"] = &Node{Kind: K_List, Required: true, Items: role}
+	return root
+}
+
+// ValidateWorkflow checks a workflow template document, see Validate.
+func ValidateWorkflow(fileName string, yamlDoc []byte) error {
+	return Validate(fileName, yamlDoc, Workflow)
+}
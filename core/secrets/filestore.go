@@ -0,0 +1,87 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileStore keeps each secret in its own file, named after the secret, in a
+// directory which must only be accessible by the user running the core.
+// Trailing newlines in the files are ignored.
+type FileStore struct {
+	dir string
+}
+
+func NewFileStore(dir string) (fs *FileStore, err error) {
+	if len(dir) == 0 {
+		err = errors.New("no secrets directory specified")
+		return
+	}
+	fs = &FileStore{dir: filepath.Clean(dir)}
+	return
+}
+
+func (fs *FileStore) Get(name string) (value string, err error) {
+	// Secret names must not escape the secrets directory
+	if strings.HasPrefix(name, "/") ||
+		strings.HasPrefix(name, ".") ||
+		strings.Contains(name, "/.") ||
+		strings.Contains(name, "\\") {
+		err = errors.New(fmt.Sprintf("invalid secret name %s", name))
+		return
+	}
+	path := filepath.Join(fs.dir, filepath.FromSlash(name))
+
+	var fi os.FileInfo
+	fi, err = os.Stat(path)
+	if os.IsNotExist(err) {
+		err = errors.New(fmt.Sprintf("no secret named %s in %s", name, fs.dir))
+		return
+	} else if err != nil {
+		return
+	}
+	if fi.IsDir() {
+		err = errors.New(fmt.Sprintf("secret %s is a directory", name))
+		return
+	}
+	if fi.Mode().Perm() & 0077 != 0 {
+		err = errors.New(fmt.Sprintf("secret file %s must not be accessible by group or others (mode is %s)", path, fi.Mode().Perm()))
+		return
+	}
+
+	var raw []byte
+	raw, err = ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	value = strings.TrimRight(string(raw), "\r\n")
+	return
+}
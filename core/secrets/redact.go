@@ -0,0 +1,116 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package secrets
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+const redactedValue = "********"
+
+var (
+	redactedMu     sync.RWMutex
+	redactedValues []string
+)
+
+// addRedacted registers a resolved secret value, so that it gets redacted
+// from now on.
+func addRedacted(value string) {
+	if len(value) == 0 {
+		return
+	}
+	redactedMu.Lock()
+	defer redactedMu.Unlock()
+	for _, v := range redactedValues {
+		if v == value {
+			return
+		}
+	}
+	redactedValues = append(redactedValues, value)
+	// Longest first, so that a secret which contains another is redacted whole
+	sort.SliceStable(redactedValues, func(i, j int) bool {
+		return len(redactedValues[i]) > len(redactedValues[j])
+	})
+}
+
+// Redact replaces every occurrence of a resolved secret value in s.
+func Redact(s string) string {
+	redactedMu.RLock()
+	defer redactedMu.RUnlock()
+	for _, value := range redactedValues {
+		s = strings.Replace(s, value, redactedValue, -1)
+	}
+	return s
+}
+
+// RedactAll is like Redact, for each string in ss.
+func RedactAll(ss []string) []string {
+	if ss == nil {
+		return nil
+	}
+	redacted := make([]string, len(ss))
+	for i, s := range ss {
+		redacted[i] = Redact(s)
+	}
+	return redacted
+}
+
+// RedactionHook is a logrus hook which redacts resolved secret values from
+// the message and fields of every log entry.
+type RedactionHook struct{}
+
+func (RedactionHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (RedactionHook) Fire(entry *logrus.Entry) error {
+	redactedMu.RLock()
+	empty := len(redactedValues) == 0
+	redactedMu.RUnlock()
+	if empty {
+		return nil
+	}
+
+	entry.Message = Redact(entry.Message)
+	// entry.Data may be shared with other entries, so we replace it rather
+	// than modifying it
+	data := make(logrus.Fields, len(entry.Data))
+	for k, v := range entry.Data {
+		// Values are only replaced by their string form if they held a secret
+		formatted := fmt.Sprintf("%v", v)
+		if redacted := Redact(formatted); redacted != formatted {
+			data[k] = redacted
+		} else {
+			data[k] = v
+		}
+	}
+	entry.Data = data
+	return nil
+}
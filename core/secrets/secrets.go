@@ -0,0 +1,195 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+// Package secrets resolves secret://name references found in task class
+// properties, command arguments and environment variables, so that
+// passwords and tokens never need to be stored in the configuration backend.
+// References are only resolved right before being sent to a task, and every
+// resolved value is redacted from the core's logs.
+package secrets
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/AliceO2Group/Control/common/logger"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+var log = logger.New(logrus.StandardLogger(), "secrets")
+
+const Scheme = "secret://"
+
+// Store is a source of secret values, looked up by name.
+type Store interface {
+	Get(name string) (value string, err error)
+}
+
+var (
+	mu       sync.RWMutex
+	instance Store
+)
+
+// Instance returns the secret store set with SetInstance or, if none was
+// set, the one configured with secretStoreUri.
+func Instance() Store {
+	mu.RLock()
+	store := instance
+	mu.RUnlock()
+	if store != nil {
+		return store
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if instance == nil {
+		storeUri := viper.GetString("secretStoreUri")
+		var err error
+		instance, err = NewStore(storeUri)
+		if err != nil {
+			log.WithField("secretStoreUri", storeUri).
+				WithError(err).
+				Error("cannot initialize secret store, secret references will not be resolved")
+			instance = brokenStore{err: err}
+		}
+	}
+	return instance
+}
+
+// SetInstance replaces the secret store, for instance with one which gets
+// secrets from an external service.
+func SetInstance(store Store) {
+	mu.Lock()
+	defer mu.Unlock()
+	instance = store
+}
+
+// NewStore builds a secret store from a URI. The only kind supported for now
+// is file:///path/to/dir, see FileStore.
+func NewStore(uri string) (store Store, err error) {
+	var u *url.URL
+	u, err = url.Parse(uri)
+	if err != nil {
+		return
+	}
+	switch u.Scheme {
+	case "file":
+		store, err = NewFileStore(u.Host + u.Path)
+	default:
+		err = errors.New(fmt.Sprintf("%s: secret store URI could not be parsed (expecting file://*)", uri))
+	}
+	return
+}
+
+type brokenStore struct {
+	err error
+}
+
+func (b brokenStore) Get(name string) (string, error) {
+	return "", errors.New(fmt.Sprintf("no secret store available: %s", b.err.Error()))
+}
+
+// IsReference returns whether value is a secret://name reference.
+func IsReference(value string) bool {
+	return strings.HasPrefix(strings.TrimSpace(value), Scheme)
+}
+
+// Resolve returns value unchanged if it isn't a secret reference, otherwise
+// it returns the value of the referenced secret.
+func Resolve(value string) (resolved string, err error) {
+	if !IsReference(value) {
+		return value, nil
+	}
+	name := strings.TrimPrefix(strings.TrimSpace(value), Scheme)
+	if len(name) == 0 {
+		err = errors.New("empty secret reference")
+		return
+	}
+	resolved, err = Instance().Get(name)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("cannot resolve secret %s: %s", name, err.Error()))
+		return
+	}
+	addRedacted(resolved)
+	return
+}
+
+// ResolveMap returns a copy of m with all secret references in its values resolved.
+func ResolveMap(m map[string]string) (resolved map[string]string, err error) {
+	if m == nil {
+		return
+	}
+	resolved = make(map[string]string, len(m))
+	for k, v := range m {
+		resolved[k], err = Resolve(v)
+		if err != nil {
+			err = errors.New(fmt.Sprintf("%s: %s", k, err.Error()))
+			return nil, err
+		}
+	}
+	return
+}
+
+// ResolveArguments returns a copy of args with all secret references resolved.
+func ResolveArguments(args []string) (resolved []string, err error) {
+	if args == nil {
+		return
+	}
+	resolved = make([]string, len(args))
+	for i, arg := range args {
+		resolved[i], err = Resolve(arg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return
+}
+
+// ResolveEnv returns a copy of env, a list of VAR=value strings, with all
+// secret references in the values resolved.
+func ResolveEnv(env []string) (resolved []string, err error) {
+	if env == nil {
+		return
+	}
+	resolved = make([]string, len(env))
+	for i, envVar := range env {
+		kv := strings.SplitN(envVar, "=", 2)
+		if len(kv) != 2 {
+			resolved[i] = envVar
+			continue
+		}
+		var value string
+		value, err = Resolve(kv[1])
+		if err != nil {
+			err = errors.New(fmt.Sprintf("%s: %s", kv[0], err.Error()))
+			return nil, err
+		}
+		resolved[i] = kv[0] + "=" + value
+	}
+	return
+}
@@ -0,0 +1,142 @@
+package secrets_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/AliceO2Group/Control/core/secrets"
+	"github.com/sirupsen/logrus"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("secrets", func() {
+	var (
+		dir string
+		err error
+	)
+
+	writeSecret := func(name string, value string, mode os.FileMode) {
+		path := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(path, []byte(value), mode)).To(Succeed())
+		Expect(os.Chmod(path, mode)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		dir, err = ioutil.TempDir("", "secrets")
+		Expect(err).NotTo(HaveOccurred())
+		var store secrets.Store
+		store, err = secrets.NewStore("file://" + dir)
+		Expect(err).NotTo(HaveOccurred())
+		secrets.SetInstance(store)
+
+		writeSecret("db_password", "hunter2\n", 0600)
+		writeSecret("token", "s3cr3t-t0ken", 0400)
+		writeSecret("shared", "everyone", 0644)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Describe("the file store", func() {
+		It("should return the secret without trailing newlines", func() {
+			value, err := secrets.Instance().Get("db_password")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("hunter2"))
+		})
+
+		It("should fail on a missing secret", func() {
+			_, err := secrets.Instance().Get("nope")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should refuse secret files readable by others", func() {
+			_, err := secrets.Instance().Get("shared")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should refuse names outside the secrets directory", func() {
+			for _, name := range []string{"../token", "/etc/passwd", "a/../../token", ".hidden"} {
+				_, err := secrets.Instance().Get(name)
+				Expect(err).To(HaveOccurred(), name)
+			}
+		})
+
+		It("should only accept file URIs", func() {
+			_, err := secrets.NewStore("consul://localhost:8500")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("resolving references", func() {
+		It("should leave plain values alone", func() {
+			Expect(secrets.IsReference("secretive")).To(BeFalse())
+			value, err := secrets.Resolve("secretive")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal("secretive"))
+		})
+
+		It("should resolve properties, arguments and environment variables", func() {
+			props, err := secrets.ResolveMap(map[string]string{
+				"user":     "alice",
+				"password": "secret://db_password",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(props).To(Equal(map[string]string{"user": "alice", "password": "hunter2"}))
+
+			args, err := secrets.ResolveArguments([]string{"--token", "secret://token"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args).To(Equal([]string{"--token", "s3cr3t-t0ken"}))
+
+			env, err := secrets.ResolveEnv([]string{"DB_PASSWORD=secret://db_password", "NOVALUE"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(env).To(Equal([]string{"DB_PASSWORD=hunter2", "NOVALUE"}))
+		})
+
+		It("should not modify its input", func() {
+			props := map[string]string{"password": "secret://db_password"}
+			_, err := secrets.ResolveMap(props)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(props["password"]).To(Equal("secret://db_password"))
+		})
+
+		It("should report which variable could not be resolved", func() {
+			_, err := secrets.ResolveEnv([]string{"API_KEY=secret://missing"})
+			Expect(err).To(MatchError(ContainSubstring("API_KEY")))
+			_, err = secrets.Resolve("secret://")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("redaction", func() {
+		BeforeEach(func() {
+			_, err = secrets.ResolveArguments([]string{"secret://db_password", "secret://token"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should redact resolved values from strings", func() {
+			Expect(secrets.Redact("--password=hunter2")).To(Equal("--password=********"))
+			Expect(secrets.RedactAll([]string{"a", "s3cr3t-t0ken"})).To(Equal([]string{"a", "********"}))
+		})
+
+		It("should redact resolved values from log entries", func() {
+			buf := new(bytes.Buffer)
+			logger := logrus.New()
+			logger.Out = buf
+			logger.Formatter = &logrus.TextFormatter{DisableColors: true}
+			logger.AddHook(secrets.RedactionHook{})
+
+			fields := logrus.Fields{"args": []string{"--token", "s3cr3t-t0ken"}, "count": 3}
+			logger.WithFields(fields).Info("connecting with password hunter2")
+
+			Expect(buf.String()).NotTo(ContainSubstring("hunter2"))
+			Expect(buf.String()).NotTo(ContainSubstring("s3cr3t-t0ken"))
+			Expect(buf.String()).To(ContainSubstring("count=3"))
+			Expect(fields["args"]).To(Equal([]string{"--token", "s3cr3t-t0ken"}))
+		})
+	})
+})
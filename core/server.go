@@ -31,12 +31,14 @@ import (
 	"runtime"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/AliceO2Group/Control/common/product"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/channel"
 	"github.com/AliceO2Group/Control/core/the"
+	"github.com/AliceO2Group/Control/core/workflow"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
@@ -188,6 +190,7 @@ func (m *RpcServer) GetEnvironments(context.Context, *pb.GetEnvironmentsRequest)
 			Tasks:            tasksToShortTaskInfos(tasks),
 			RootRole:         env.Workflow().GetName(),
 			CurrentRunNumber: env.GetCurrentRunNumber(),
+			ConfigurationChanged: env.ConfigurationChanged(),
 		}
 
 		r.Environments = append(r.Environments, e)
@@ -222,7 +225,7 @@ func (m *RpcServer) NewEnvironment(cxt context.Context, request *pb.NewEnvironme
 	}
 
 	// Create new Environment instance with some roles, we get back a UUID
-	id, err := m.state.environments.CreateEnvironment(request.GetWorkflowTemplate())
+	id, err := m.state.environments.CreateEnvironment(request.GetWorkflowTemplate(), request.GetVars())
 	if err != nil {
 		return nil, status.Newf(codes.Internal, "cannot create new environment: %s", err.Error()).Err()
 	}
@@ -241,6 +244,7 @@ func (m *RpcServer) NewEnvironment(cxt context.Context, request *pb.NewEnvironme
 			Tasks: tasksToShortTaskInfos(tasks),
 			RootRole: newEnv.Workflow().GetName(),
 			CurrentRunNumber: newEnv.GetCurrentRunNumber(),
+			ConfigurationChanged: newEnv.ConfigurationChanged(),
 		},
 	}
 
@@ -270,12 +274,38 @@ func (m *RpcServer) GetEnvironment(cxt context.Context, req *pb.GetEnvironmentRe
 			Tasks: tasksToShortTaskInfos(tasks),
 			RootRole: env.Workflow().GetName(),
 			CurrentRunNumber: env.GetCurrentRunNumber(),
+			ConfigurationChanged: env.ConfigurationChanged(),
 		},
 		Workflow: workflowToRoleTree(env.Workflow()),
 	}
 	return r, nil
 }
 
+// GetEnvironmentTopology returns the tasks of an environment along with the
+// channels which connect them.
+func (m *RpcServer) GetEnvironmentTopology(cxt context.Context, req *pb.GetEnvironmentTopologyRequest) (*pb.GetEnvironmentTopologyReply, error) {
+	m.logMethod()
+	m.state.RLock()
+	defer m.state.RUnlock()
+
+	if req == nil || len(req.Id) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	env, err := m.state.environments.Environment(uuid.Parse(req.Id))
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "environment not found: %s", err.Error()).Err()
+	}
+
+	nodes, edges := tasksToTopology(env.Workflow().GetTasks())
+	r := &pb.GetEnvironmentTopologyReply{
+		EnvironmentId: env.Id().String(),
+		Nodes: nodes,
+		Edges: edges,
+	}
+	return r, nil
+}
+
 func (m *RpcServer) ControlEnvironment(cxt context.Context, req *pb.ControlEnvironmentRequest) (*pb.ControlEnvironmentReply, error) {
 	m.logMethod()
 	m.state.RLock()
@@ -502,6 +532,34 @@ func (m *RpcServer) GetWorkflowTemplates(cxt context.Context, req *pb.GetWorkflo
 	return &pb.GetWorkflowTemplatesReply{WorkflowTemplates: workflowTemplateInfos}, nil
 }
 
+// RenderWorkflowTemplate loads a workflow template without creating an
+// environment, and returns the expanded role tree along with any problem
+// which would otherwise only show up when deploying or configuring it.
+func (m *RpcServer) RenderWorkflowTemplate(cxt context.Context, req *pb.RenderWorkflowTemplateRequest) (*pb.RenderWorkflowTemplateReply, error) {
+	m.logMethod()
+	m.state.RLock()
+	defer m.state.RUnlock()
+
+	if req == nil || len(req.WorkflowTemplate) == 0 {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	root, workflowRevision, problems, err := workflow.Render(the.ConfSvc().GetROSource(), req.WorkflowTemplate, m.state.taskman, req.Vars)
+	if err != nil {
+		// A workflow which cannot be loaded is reported as a problem, schema
+		// validation errors come one per line
+		return &pb.RenderWorkflowTemplateReply{
+			Problems: strings.Split(strings.TrimSpace(err.Error()), "\n"),
+		}, nil
+	}
+
+	return &pb.RenderWorkflowTemplateReply{
+		WorkflowRevision: workflowRevision,
+		Workflow: workflowToRenderedRole(root),
+		Problems: problems,
+	}, nil
+}
+
 func (m *RpcServer) ListRepos(cxt context.Context, req *pb.ListReposRequest) (*pb.ListReposReply, error) {
 	m.logMethod()
 
@@ -532,7 +590,7 @@ func (m *RpcServer) AddRepo(cxt context.Context, req *pb.AddRepoRequest) (*pb.Ad
 
 	err := the.RepoManager().AddRepo(req.Name)
 	if err == nil { //new Repo -> refresh
-		return &pb.AddRepoReply{ErrorString: "" }, nil
+		return &pb.AddRepoReply{ErrorString: "", ValidationErrors: the.RepoManager().ValidateRepo(req.Name)}, nil
 	} else {
 		return &pb.AddRepoReply{ErrorString: err.Error() }, nil
 	}
@@ -559,16 +617,23 @@ func (m *RpcServer) RefreshRepos(cxt context.Context, req *pb.RefreshReposReques
 	}
 
 	var err error
+	var validationErrors []string
 	if int(req.Index) == -1 {
 		err = the.RepoManager().RefreshRepos()
+		if err == nil {
+			validationErrors = the.RepoManager().ValidateRepos()
+		}
 	} else {
 		err = the.RepoManager().RefreshRepoByIndex(int(req.Index))
+		if err == nil {
+			validationErrors = the.RepoManager().ValidateRepoByIndex(int(req.Index))
+		}
 	}
 	if err != nil {
 		return &pb.RefreshReposReply{ErrorString: err.Error()}, nil
 	}
 
-	return &pb.RefreshReposReply{ErrorString: ""}, nil
+	return &pb.RefreshReposReply{ErrorString: "", ValidationErrors: validationErrors}, nil
 }
 
 func (m *RpcServer) SetDefaultRepo(cxt context.Context, req *pb.SetDefaultRepoRequest) (*pb.SetDefaultRepoReply, error) {
@@ -584,4 +649,72 @@ func (m *RpcServer) SetDefaultRepo(cxt context.Context, req *pb.SetDefaultRepoRe
 	} else {
 		return &pb.SetDefaultRepoReply{ErrorString: ""}, nil
 	}
-}
\ No newline at end of file
+}
+
+func (m *RpcServer) GetRunConfiguration(cxt context.Context, req *pb.GetRunConfigurationRequest) (*pb.GetRunConfigurationReply, error) {
+	m.logMethod()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+	if req.RunNumber == 0 {
+		return nil, status.New(codes.InvalidArgument, "run number must be greater than 0").Err()
+	}
+
+	runConfiguration, err := the.ConfSvc().GetRunConfiguration(req.RunNumber)
+	if err != nil {
+		return nil, status.Newf(codes.NotFound, "cannot get configuration for run %d: %s", req.RunNumber, err.Error()).Err()
+	}
+
+	return runConfigurationToPb(req.RunNumber, runConfiguration.Map()), nil
+}
+
+
+func (m *RpcServer) RefreshConfig(cxt context.Context, req *pb.RefreshConfigRequest) (*pb.RefreshConfigReply, error) {
+	m.logMethod()
+
+	if req == nil {
+		return nil, status.New(codes.InvalidArgument, "received nil request").Err()
+	}
+
+	changes, err := refreshCoreConfig()
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot reload core configuration: %s", err.Error()).Err()
+	}
+
+	r := &pb.RefreshConfigReply{Changes: make([]*pb.SettingChange, 0)}
+	for _, change := range changes {
+		r.Changes = append(r.Changes, &pb.SettingChange{
+			Key:             change.key,
+			OldValue:        change.oldValue,
+			NewValue:        change.newValue,
+			RestartRequired: change.restartRequired,
+		})
+	}
+
+	addedRepos, newDefaultRepo, err := the.RepoManager().ReloadRepos()
+	if err != nil {
+		return nil, status.Newf(codes.Internal, "cannot reload repositories: %s", err.Error()).Err()
+	}
+	if len(addedRepos) > 0 {
+		r.Changes = append(r.Changes, &pb.SettingChange{
+			Key:      "repositories",
+			NewValue: strings.Join(addedRepos, ", "),
+		})
+	}
+	if len(newDefaultRepo) > 0 {
+		r.Changes = append(r.Changes, &pb.SettingChange{
+			Key:      "default_repo",
+			NewValue: newDefaultRepo,
+		})
+	}
+
+	// Repositories may have changed, so task classes are re-read as well
+	err = m.state.taskman.RefreshKnownClasses()
+	if err != nil {
+		log.WithError(err).
+			Warning("cannot refresh task classes after configuration reload")
+	}
+
+	return r, nil
+}
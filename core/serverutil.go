@@ -25,8 +25,14 @@
 package core
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/configuration"
 	"github.com/AliceO2Group/Control/core/protos"
+	"github.com/AliceO2Group/Control/core/secrets"
 	"github.com/AliceO2Group/Control/core/task/channel"
 
 	"github.com/AliceO2Group/Control/core/task"
@@ -38,10 +44,10 @@ func commandInfoToPbCommandInfo(c *common.TaskCommandInfo) (pci *pb.CommandInfo)
 		return
 	}
 	pci = &pb.CommandInfo{
-		Env: c.GetEnv(),
+		Env: secrets.RedactAll(c.GetEnv()),
 		Shell: c.GetShell(),
-		Value: c.GetValue(),
-		Arguments: c.GetArguments(),
+		Value: secrets.Redact(c.GetValue()),
+		Arguments: secrets.RedactAll(c.GetArguments()),
 		User: c.GetUser(),
 	}
 	return
@@ -124,6 +130,65 @@ func tasksToShortTaskInfos(tasks []*task.Task) (stis []*pb.ShortTaskInfo) {
 	return
 }
 
+// tasksToTopology resolves the outbound channels of FairMQ tasks against the
+// inbound channels bound by the tasks, the same way they are resolved when
+// the environment is configured.
+func tasksToTopology(tasks task.Tasks) (nodes []*pb.TopologyNode, edges []*pb.TopologyEdge) {
+	nodes = make([]*pb.TopologyNode, 0, len(tasks))
+	edges = make([]*pb.TopologyEdge, 0)
+	if tasks == nil {
+		return
+	}
+
+	bindMap := tasks.BuildBindMap()
+	tasksByPath := make(map[string]*task.Task)
+	for _, t := range tasks {
+		sti := taskToShortTaskInfo(t)
+		nodes = append(nodes, &pb.TopologyNode{
+			TaskId: t.GetTaskId(),
+			Name: t.GetName(),
+			RolePath: t.GetParentRolePath(),
+			Hostname: t.GetHostname(),
+			ClassName: t.GetClassName(),
+			State: sti.State,
+		})
+		tasksByPath[t.GetParentRolePath()] = t
+	}
+
+	type parentRole interface {
+		CollectOutboundChannels() []channel.Outbound
+	}
+	for _, t := range tasks {
+		class := t.GetTaskClass()
+		if class == nil || class.Control.Mode != controlmode.FAIRMQ {
+			continue
+		}
+		parent, ok := t.GetParentRole().(parentRole)
+		if !ok {
+			continue
+		}
+		for _, outboundCh := range parent.CollectOutboundChannels() {
+			edge := &pb.TopologyEdge{
+				SourceTaskId: t.GetTaskId(),
+				Name: outboundCh.Name,
+				Type: outboundCh.Type.String(),
+				Target: outboundCh.Target,
+			}
+			if outboundCh.HasExplicitTarget() {
+				edge.Endpoint = outboundCh.Target
+			} else if endpoint, ok := bindMap[outboundCh.Target]; ok {
+				edge.Endpoint = endpoint.String()
+				targetPath := strings.SplitN(outboundCh.Target, ":", 2)[0]
+				if targetTask, ok := tasksByPath[targetPath]; ok {
+					edge.TargetTaskId = targetTask.GetTaskId()
+				}
+			}
+			edges = append(edges, edge)
+		}
+	}
+	return
+}
+
 func tasksToTaskIds(tasks []*task.Task) (ss []string) {
 	if tasks == nil {
 		return
@@ -144,6 +209,16 @@ func workflowToRoleTree(root workflow.Role) (ri *pb.RoleInfo) {
 	for i, cr := range childRoles {
 		childRoleInfos[i] = workflowToRoleTree(cr)
 	}
+	// Pruned roles never ran, so we only report what they are and why they
+	// were disabled
+	for _, pr := range root.GetPrunedRoles() {
+		childRoleInfos = append(childRoleInfos, &pb.RoleInfo{
+			Name: pr.GetName(),
+			FullPath: pr.GetPath(),
+			Pruned: true,
+			PruneReason: pr.GetPruneReason(),
+		})
+	}
 	ri = &pb.RoleInfo{
 		Name: root.GetName(),
 		Status: root.GetStatus().String(),
@@ -151,6 +226,116 @@ func workflowToRoleTree(root workflow.Role) (ri *pb.RoleInfo) {
 		FullPath: root.GetPath(),
 		TaskIds: tasksToTaskIds(root.GetTasks()),
 		Roles: childRoleInfos,
+		Vars: scopedVarsToVarInfos(root.GetEffectiveVars()),
+	}
+	return
+}
+
+func scopedVarsToVarInfos(vars task.ScopedVarMap) (vis []*pb.VarInfo) {
+	vis = make([]*pb.VarInfo, 0, len(vars))
+	for k, v := range vars {
+		vis = append(vis, &pb.VarInfo{
+			Key: k,
+			Value: secrets.Redact(v.Value),
+			Source: v.Source,
+		})
+	}
+	sort.Slice(vis, func(i, j int) bool {
+		return vis[i].Key < vis[j].Key
+	})
+	return
+}
+
+func workflowToRenderedRole(root workflow.Role) (rr *pb.RenderedRole) {
+	if root == nil {
+		return
+	}
+	childRoles := root.GetRoles()
+	childRenderedRoles := make([]*pb.RenderedRole, 0, len(childRoles))
+	for _, cr := range childRoles {
+		childRenderedRoles = append(childRenderedRoles, workflowToRenderedRole(cr))
+	}
+	for _, pr := range root.GetPrunedRoles() {
+		childRenderedRoles = append(childRenderedRoles, &pb.RenderedRole{
+			Name: pr.GetName(),
+			FullPath: pr.GetPath(),
+			Pruned: true,
+			PruneReason: pr.GetPruneReason(),
+		})
+	}
+	rr = &pb.RenderedRole{
+		Name: root.GetName(),
+		FullPath: root.GetPath(),
+		Vars: root.GetVars(),
+		Roles: childRenderedRoles,
+	}
+
+	// Only task roles have a class, and we show them with all the outbound
+	// channels they get, including the ones inherited from their ancestors
+	type _taskRole interface {
+		GetTaskClass() string
+		CollectOutboundChannels() []channel.Outbound
+	}
+	if t, ok := root.(_taskRole); ok {
+		rr.TaskClass = t.GetTaskClass()
+		for _, outboundCh := range t.CollectOutboundChannels() {
+			rr.OutboundChannels = append(rr.OutboundChannels, &pb.RenderedChannel{
+				Name: outboundCh.Name,
+				Type: outboundCh.Type.String(),
+				Target: outboundCh.Target,
+			})
+		}
+	}
+	return
+}
+
+func runConfigurationToPb(runNumber uint32, rc configuration.Map) (reply *pb.GetRunConfigurationReply) {
+	getValue := func(m configuration.Map, key string) string {
+		if item, ok := m[key]; ok && item != nil && item.Type() == configuration.IT_Value {
+			return item.Value()
+		}
+		return ""
+	}
+
+	reply = &pb.GetRunConfigurationReply{
+		RunNumber:        runNumber,
+		EnvironmentId:    getValue(rc, "environment_id"),
+		WorkflowTemplate: getValue(rc, "workflow"),
+		WorkflowRevision: getValue(rc, "workflow_revision"),
+		StartedWhen:      getValue(rc, "started"),
+		Tasks:            make([]*pb.RunTaskConfiguration, 0),
+	}
+
+	tasksItem, ok := rc["tasks"]
+	if !ok || tasksItem == nil || tasksItem.Type() != configuration.IT_Map {
+		return
+	}
+	taskIds := make([]string, 0, len(tasksItem.Map()))
+	for taskId := range tasksItem.Map() {
+		taskIds = append(taskIds, taskId)
+	}
+	sort.Strings(taskIds)
+
+	for _, taskId := range taskIds {
+		taskItem := tasksItem.Map()[taskId]
+		if taskItem == nil || taskItem.Type() != configuration.IT_Map {
+			continue
+		}
+		properties := make(map[string]string)
+		if propertiesItem, ok := taskItem.Map()["properties"]; ok && propertiesItem != nil && propertiesItem.Type() == configuration.IT_Map {
+			for k, v := range propertiesItem.Map() {
+				if v != nil && v.Type() == configuration.IT_Value {
+					properties[k] = v.Value()
+				}
+			}
+		}
+		reply.Tasks = append(reply.Tasks, &pb.RunTaskConfiguration{
+			TaskId:     taskId,
+			Name:       getValue(taskItem.Map(), "name"),
+			ClassName:  getValue(taskItem.Map(), "class"),
+			Hostname:   getValue(taskItem.Map(), "hostname"),
+			Properties: properties,
+		})
 	}
 	return
 }
@@ -26,6 +26,7 @@ package task
 
 import (
 	"github.com/mesos/mesos-go/api/v1/lib"
+	"sort"
 	"sync"
 	"github.com/AliceO2Group/Control/core/task/constraint"
 )
@@ -76,4 +77,25 @@ func (ac *AgentCache) Count() (count int) {
 		return 0
 	}
 	return len(ac.store)
-}
\ No newline at end of file
+}
+
+// Filter returns the cached agents whose attributes satisfy cts, sorted by
+// hostname.
+func (ac *AgentCache) Filter(cts constraint.Constraints) (agents []AgentCacheInfo) {
+	agents = make([]AgentCacheInfo, 0)
+	if ac == nil || ac.store == nil {
+		return
+	}
+	ac.mu.RLock()
+	defer ac.mu.RUnlock()
+
+	for _, aci := range ac.store {
+		if aci.Attributes.Satisfy(cts) {
+			agents = append(agents, aci)
+		}
+	}
+	sort.Slice(agents, func(i, j int) bool {
+		return agents[i].Hostname < agents[j].Hostname
+	})
+	return
+}
@@ -28,23 +28,33 @@ import (
 	"strconv"
 	"strings"
 	"errors"
+
+	"github.com/AliceO2Group/Control/core/controlcommands"
 )
 
 type channel struct {
-	Name        string                  `yaml:"name"`
-	Type        ChannelType             `yaml:"type"`
-	SndBufSize  int                     `yaml:"sndBufSize"`
-	RcvBufSize  int                     `yaml:"rcvBufSize"`
-	RateLogging int                     `yaml:"rateLogging"`
+	Name          string                  `yaml:"name"`
+	Type          ChannelType             `yaml:"type"`
+	SndBufSize    int                     `yaml:"sndBufSize"`
+	RcvBufSize    int                     `yaml:"rcvBufSize"`
+	SndKernelSize int                     `yaml:"sndKernelSize"`
+	RcvKernelSize int                     `yaml:"rcvKernelSize"`
+	RateLogging   int                     `yaml:"rateLogging"`
+	Transport     TransportType           `yaml:"transport"`
+	NumSockets    int                     `yaml:"numSockets"`
 }
 
 func (c *channel) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 	type _channel struct {
-		Name        string                  `yaml:"name"`
-		Type        ChannelType             `yaml:"type"`
-		SndBufSize  string                  `yaml:"sndBufSize"`
-		RcvBufSize  string                  `yaml:"rcvBufSize"`
-		RateLogging string                  `yaml:"rateLogging"`
+		Name          string                  `yaml:"name"`
+		Type          ChannelType             `yaml:"type"`
+		SndBufSize    string                  `yaml:"sndBufSize"`
+		RcvBufSize    string                  `yaml:"rcvBufSize"`
+		SndKernelSize string                  `yaml:"sndKernelSize"`
+		RcvKernelSize string                  `yaml:"rcvKernelSize"`
+		RateLogging   string                  `yaml:"rateLogging"`
+		Transport     TransportType           `yaml:"transport"`
+		NumSockets    string                  `yaml:"numSockets"`
 	}
 	aux := _channel{}
 	err = unmarshal(&aux)
@@ -54,15 +64,28 @@ func (c *channel) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 
 	c.Name = aux.Name
 	c.Type = aux.Type
+	c.Transport = aux.Transport
 	if aux.SndBufSize == "" {
 		aux.SndBufSize = "1000"
 	}
 	if aux.RcvBufSize == "" {
 		aux.RcvBufSize = "1000"
 	}
+	if aux.SndKernelSize == "" {
+		aux.SndKernelSize = "0"
+	}
+	if aux.RcvKernelSize == "" {
+		aux.RcvKernelSize = "0"
+	}
 	if aux.RateLogging == "" {
 		aux.RateLogging = "0"
 	}
+	if aux.Transport == "" {
+		c.Transport = DEFAULT
+	}
+	if aux.NumSockets == "" {
+		aux.NumSockets = "1"
+	}
 
 	c.SndBufSize, err = strconv.Atoi(aux.SndBufSize)
 	if err != nil {
@@ -72,37 +95,144 @@ func (c *channel) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 	if err != nil {
 		return
 	}
+	c.SndKernelSize, err = strconv.Atoi(aux.SndKernelSize)
+	if err != nil {
+		return
+	}
+	c.RcvKernelSize, err = strconv.Atoi(aux.RcvKernelSize)
+	if err != nil {
+		return
+	}
 	c.RateLogging, err = strconv.Atoi(aux.RateLogging)
 	if err != nil {
 		return
 	}
+	c.NumSockets, err = strconv.Atoi(aux.NumSockets)
+	if err != nil {
+		return
+	}
+	if c.NumSockets < 1 {
+		return errors.New("invalid number of sockets for channel " + c.Name + ": " + aux.NumSockets)
+	}
 
 	return
 }
 
-// TODO: FairMQ has the following channel types:
-// push/pull/pub/sub/spub/xsub/pair/req/rep/dealer/router
-// Do we need to support them all?
+// buildFMQMap generates the FairMQ property map of a channel, with one
+// sub-socket for each of the given addresses.
+func (c *channel) buildFMQMap(method string, addresses []string) (pm controlcommands.PropertyMap) {
+	pm = make(controlcommands.PropertyMap)
+	const chans = "chans"
+	chName := c.Name
+	pm[strings.Join([]string{chans, chName, "numSockets"}, ".")] = strconv.Itoa(len(addresses))
+
+	for i, address := range addresses {
+		prefix := strings.Join([]string{chans, chName, strconv.Itoa(i)}, ".")
+
+		chanProps := controlcommands.PropertyMap{
+			"address": address,
+			"method": method,
+			"rateLogging": strconv.Itoa(c.RateLogging),
+			"rcvBufSize": strconv.Itoa(c.RcvBufSize),
+			"rcvKernelSize": strconv.Itoa(c.RcvKernelSize),
+			"sndBufSize": strconv.Itoa(c.SndBufSize),
+			"sndKernelSize": strconv.Itoa(c.SndKernelSize),
+			"transport": c.Transport.String(),
+			"type": c.Type.String(),
+		}
+
+		for k, v := range chanProps {
+			pm[prefix + "." + k] = v
+		}
+	}
+	return
+}
+
 type ChannelType string
 const (
-	PUSH = ChannelType("push")
-	PULL = ChannelType("pull")
-	PUB  = ChannelType("pub")
-	SUB  = ChannelType("sub")
+	PUSH   = ChannelType("push")
+	PULL   = ChannelType("pull")
+	PUB    = ChannelType("pub")
+	SUB    = ChannelType("sub")
+	XPUB   = ChannelType("xpub")
+	XSUB   = ChannelType("xsub")
+	PAIR   = ChannelType("pair")
+	REQ    = ChannelType("req")
+	REP    = ChannelType("rep")
+	DEALER = ChannelType("dealer")
+	ROUTER = ChannelType("router")
 )
 
+// compatibleTypes lists, for each socket type, the socket types it can be
+// connected to, as in the ZeroMQ specification.
+var compatibleTypes = map[ChannelType][]ChannelType{
+	PUSH:   {PULL},
+	PULL:   {PUSH},
+	PUB:    {SUB, XSUB},
+	SUB:    {PUB, XPUB},
+	XPUB:   {SUB, XSUB},
+	XSUB:   {PUB, XPUB},
+	PAIR:   {PAIR},
+	REQ:    {REP, ROUTER},
+	REP:    {REQ, DEALER},
+	DEALER: {REP, DEALER, ROUTER},
+	ROUTER: {REQ, DEALER, ROUTER},
+}
+
 func (ct ChannelType) String() string {
 	return string(ct)
 }
 
+// IsCompatible tells whether a socket of type ct can talk to a socket of
+// type other on the other end of a connection.
+func (ct ChannelType) IsCompatible(other ChannelType) bool {
+	for _, compatible := range compatibleTypes[ct] {
+		if compatible == other {
+			return true
+		}
+	}
+	return false
+}
+
 func (ct *ChannelType) UnmarshalText(b []byte) error {
 	str := strings.ToLower(strings.Trim(string(b), `"`))
 
+	if _, ok := compatibleTypes[ChannelType(str)]; !ok {
+		return errors.New("invalid channel type: " + str)
+	}
+	*ct = ChannelType(str)
+
+	return nil
+}
+
+// TransportType is the FairMQ transport of a channel, DEFAULT leaves the
+// choice to the device configuration.
+type TransportType string
+const (
+	DEFAULT = TransportType("default")
+	ZEROMQ  = TransportType("zeromq")
+	SHMEM   = TransportType("shmem")
+	NANOMSG = TransportType("nanomsg")
+)
+
+func (tt TransportType) String() string {
+	return string(tt)
+}
+
+// IsCompatible tells whether two channels with transports tt and other can be
+// connected. A DEFAULT transport is assumed to match the other end.
+func (tt TransportType) IsCompatible(other TransportType) bool {
+	return tt == DEFAULT || other == DEFAULT || tt == other
+}
+
+func (tt *TransportType) UnmarshalText(b []byte) error {
+	str := strings.ToLower(strings.Trim(string(b), `"`))
+
 	switch str {
-	case PUSH.String(), PULL.String(), PUB.String(), SUB.String():
-		*ct = ChannelType(str)
+	case DEFAULT.String(), ZEROMQ.String(), SHMEM.String(), NANOMSG.String():
+		*tt = TransportType(str)
 	default:
-		return errors.New("invalid channel type: " + str)
+		return errors.New("invalid channel transport: " + str)
 	}
 
 	return nil
@@ -25,10 +25,6 @@
 package channel
 
 import (
-	"fmt"
-	"strconv"
-	"strings"
-
 	"github.com/AliceO2Group/Control/core/controlcommands"
 )
 
@@ -50,32 +46,6 @@ chans.data1.0.type          = push
 chans.data1.numSockets      = 1
  */
 
-func (inbound *Inbound) ToFMQMap(port uint64) (pm controlcommands.PropertyMap) {
-	return inbound.buildFMQMap(fmt.Sprintf("tcp://*:%d", port))
+func (inbound *Inbound) ToFMQMap(endpoint Endpoint) (pm controlcommands.PropertyMap) {
+	return inbound.buildFMQMap("bind", endpoint.BindAddresses())
 }
-
-func (inbound *Inbound) buildFMQMap(address string) (pm controlcommands.PropertyMap) {
-	pm = make(controlcommands.PropertyMap)
-	const chans = "chans"
-	chName := inbound.Name
-	// We assume one socket per channel, so this must always be set
-	pm[strings.Join([]string{chans, chName, "numSockets"}, ".")] = "1"
-	prefix := strings.Join([]string{chans, chName, "0"}, ".")
-
-	chanProps := controlcommands.PropertyMap{
-		"address": address,
-		"method": "bind",
-		"rateLogging": strconv.Itoa(inbound.RateLogging),
-		"rcvBufSize": strconv.Itoa(inbound.RcvBufSize),
-		"rcvKernelSize": "0", //NOTE: hardcoded
-		"sndBufSize": strconv.Itoa(inbound.SndBufSize),
-		"sndKernelSize": "0", //NOTE: hardcoded
-		"transport": "default", //NOTE: hardcoded
-		"type": inbound.Type.String(),
-	}
-
-	for k, v := range chanProps {
-		pm[prefix + "." + k] = v
-	}
-	return
-}
\ No newline at end of file
@@ -25,16 +25,62 @@
 package channel
 
 import (
+	"crypto/sha1"
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/AliceO2Group/Control/core/controlcommands"
 )
 
+// Endpoint is where an inbound channel is bound, with one port for each of its
+// sub-sockets. If IpcPaths is set, the channel is bound on ipc sockets instead.
 type Endpoint struct {
-	Host string
-	Port uint64
+	Host      string
+	Ports     []uint64
+	Transport TransportType
+	IpcPaths  []string
+}
+
+// Addresses returns the addresses an outbound channel should connect to, one
+// for each sub-socket of the inbound channel.
+func (e Endpoint) Addresses() (addresses []string) {
+	if len(e.IpcPaths) != 0 {
+		addresses = make([]string, len(e.IpcPaths))
+		for i, ipcPath := range e.IpcPaths {
+			addresses[i] = "ipc://" + ipcPath
+		}
+		return
+	}
+	addresses = make([]string, len(e.Ports))
+	for i, port := range e.Ports {
+		addresses[i] = fmt.Sprintf("tcp://%s:%d", e.Host, port)
+	}
+	return
+}
+
+// BindAddresses returns the addresses the sub-sockets of the inbound channel
+// should bind to.
+func (e Endpoint) BindAddresses() (addresses []string) {
+	if len(e.IpcPaths) != 0 {
+		return e.Addresses()
+	}
+	addresses = make([]string, len(e.Ports))
+	for i, port := range e.Ports {
+		addresses[i] = fmt.Sprintf("tcp://*:%d", port)
+	}
+	return
+}
+
+func (e Endpoint) String() string {
+	return strings.Join(e.Addresses(), ",")
+}
+
+// IpcPath generates the path of the ipc socket of a sub-socket of an inbound
+// channel. It is unique for each environment and channel path, and short
+// enough to fit in a Unix socket address.
+func IpcPath(envId string, bindPath string, subSocket int) string {
+	hash := sha1.Sum([]byte(bindPath))
+	return fmt.Sprintf("/tmp/aliecs-%s-%x-%d", envId, hash[:6], subSocket)
 }
 
 type BindMap map[string]Endpoint
@@ -79,58 +125,40 @@ chans.data1.0.type          = pull
 chans.data1.numSockets      = 1
 */
 
+// ToFMQMap resolves the target of the outbound channel and generates its
+// FairMQ property map. If the target is an inbound channel with several
+// sub-sockets, each sub-socket of the outbound channel connects to one of them
+// in turn.
 func (outbound *Outbound) ToFMQMap(bindMap BindMap) (pm controlcommands.PropertyMap) {
 	if outbound == nil {
 		return
 	}
 
-	var address string
+	var targetAddresses []string
 	// If an explicit target was provided, we use it
-	if strings.HasPrefix(outbound.Target, "tcp://") ||
-		strings.HasPrefix(outbound.Target, "ipc://") {
-		address = outbound.Target
+	if outbound.HasExplicitTarget() {
+		targetAddresses = []string{outbound.Target}
 	} else {
 		// we don't need class.Bind data for this one, only task.bindPorts after resolving paths!
-		for chPath, endpoint := range bindMap {
-			// FIXME: implement more sophisticated channel matching here
-			if outbound.Target == chPath {
-
-				// We have a match, so we generate a resolved target address and break
-				address = fmt.Sprintf("tcp://%s:%d", endpoint.Host, endpoint.Port)
-				break
-			}
+		if endpoint, ok := bindMap[outbound.Target]; ok {
+			targetAddresses = endpoint.Addresses()
 		}
 	}
 
-	if len(address) == 0 {
+	if len(targetAddresses) == 0 {
 		return
 	}
 
-	return outbound.buildFMQMap(address)
-}
-
-func (outbound *Outbound) buildFMQMap(address string) (pm controlcommands.PropertyMap) {
-	pm = make(controlcommands.PropertyMap)
-	const chans = "chans"
-	chName := outbound.Name
-	// We assume one socket per channel, so this must always be set
-	pm[strings.Join([]string{chans, chName, "numSockets"}, ".")] = "1"
-	prefix := strings.Join([]string{chans, chName, "0"}, ".")
-
-	chanProps := controlcommands.PropertyMap{
-		"address": address,
-		"method": "connect",
-		"rateLogging": strconv.Itoa(outbound.RateLogging),
-		"rcvBufSize": strconv.Itoa(outbound.RcvBufSize),
-		"rcvKernelSize": "0", //NOTE: hardcoded
-		"sndBufSize": strconv.Itoa(outbound.SndBufSize),
-		"sndKernelSize": "0", //NOTE: hardcoded
-		"transport": "default", //NOTE: hardcoded
-		"type": outbound.Type.String(),
+	addresses := make([]string, outbound.NumSockets)
+	for i := range addresses {
+		addresses[i] = targetAddresses[i % len(targetAddresses)]
 	}
+	return outbound.buildFMQMap("connect", addresses)
+}
 
-	for k, v := range chanProps {
-		pm[prefix + "." + k] = v
-	}
-	return
-}
\ No newline at end of file
+// HasExplicitTarget tells whether the outbound channel connects to an address
+// rather than to the path of an inbound channel in the workflow.
+func (outbound *Outbound) HasExplicitTarget() bool {
+	return strings.HasPrefix(outbound.Target, "tcp://") ||
+		strings.HasPrefix(outbound.Target, "ipc://")
+}
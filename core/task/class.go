@@ -43,11 +43,14 @@ type info struct {
 	Identifier  taskClassIdentifier		`yaml:"name"`
 	Control     struct {
 		Mode    controlmode.ControlMode `yaml:"mode"`
+		Hooks   *common.HookInfo        `yaml:"hooks"`
 	}                                   `yaml:"control"`
 	Command     *common.CommandInfo     `yaml:"command"`
+	Restart     common.RestartInfo      `yaml:"restart"`
 	Wants       ResourceWants           `yaml:"wants"`
 	Bind        []channel.Inbound       `yaml:"bind"`
 	Properties  controlcommands.PropertyMap `yaml:"properties"`
+	Defaults    VarMap                  `yaml:"defaults"`
 	Constraints []constraint.Constraint `yaml:"constraints"`
 }
 
@@ -29,6 +29,8 @@ import (
 	"fmt"
 	"github.com/AliceO2Group/Control/common/utils"
 	"github.com/AliceO2Group/Control/core/repos"
+	"github.com/AliceO2Group/Control/core/schema"
+	"github.com/AliceO2Group/Control/core/secrets"
 	"github.com/AliceO2Group/Control/core/the"
 	"github.com/spf13/viper"
 	"gopkg.in/yaml.v2"
@@ -38,7 +40,6 @@ import (
 	"sync"
 
 	"github.com/AliceO2Group/Control/core/controlcommands"
-	"github.com/AliceO2Group/Control/core/task/channel"
 	"github.com/k0kubun/pp"
 	"github.com/mesos/mesos-go/api/v1/lib"
 	"github.com/pborman/uuid"
@@ -85,7 +86,7 @@ func NewManager(resourceOffersDone <-chan DeploymentMap,
 // matching role requests with offers (matchRoles).
 // The new role is not assigned to an environment and comes without a roleClass
 // function, as those two are filled out later on by Manager.AcquireTasks.
-func (m*Manager) NewTaskForMesosOffer(offer *mesos.Offer, descriptor *Descriptor, bindPorts map[string]uint64, executorId mesos.ExecutorID) (t *Task) {
+func (m*Manager) NewTaskForMesosOffer(offer *mesos.Offer, descriptor *Descriptor, bindPorts map[string][]uint64, executorId mesos.ExecutorID) (t *Task) {
 	newId := uuid.NewUUID().String()
 	t = &Task{
 		name:         fmt.Sprintf("%s#%s", descriptor.TaskClassName, newId),
@@ -104,9 +105,9 @@ func (m*Manager) NewTaskForMesosOffer(offer *mesos.Offer, descriptor *Descriptor
 	t.GetTaskClass = func() *TaskClass {
 		return m.GetTaskClass(t.className)
 	}
-	t.bindPorts = make(map[string]uint64)
+	t.bindPorts = make(map[string][]uint64)
 	for k, v := range bindPorts {
-		t.bindPorts[k] = v
+		t.bindPorts[k] = append([]uint64{}, v...)
 	}
 	return
 }
@@ -130,7 +131,20 @@ func getTaskClassList(taskClassesRequired []string) (taskClassList []*TaskClass,
 			return nil, errors.New("getTaskClassList: repo not found for " + taskClass)
 		}
 
-		yamlData, err = ioutil.ReadFile(viper.GetString("repositoriesPath") + taskClassFile)
+		// Task classes resolved from workflows carry the hash of the revision
+		// they were resolved against, which may not be the one checked out,
+		// e.g. for workflows included at a pinned revision
+		hash := repo.Hash
+		if len(taskClassString) == 2 && len(taskClassString[1]) != 0 {
+			hash = taskClassString[1]
+			yamlData, err = repo.ReadFileAtHash(hash, "tasks/" + strings.SplitN(taskClassFile, "tasks/", 2)[1])
+		} else {
+			yamlData, err = ioutil.ReadFile(viper.GetString("repositoriesPath") + taskClassFile)
+		}
+		if err != nil {
+			return nil, err
+		}
+		err = schema.ValidateTaskClass(taskClassFile, yamlData)
 		if err != nil {
 			return nil, err
 		}
@@ -141,12 +155,27 @@ func getTaskClassList(taskClassesRequired []string) (taskClassList []*TaskClass,
 		}
 
 		taskClassStruct.Identifier.repoIdentifier = repo.GetIdentifier()
-		taskClassStruct.Identifier.hash = repo.Hash
+		taskClassStruct.Identifier.hash = hash
 		taskClassList = append(taskClassList, &taskClassStruct)
 	}
 	return taskClassList, nil
 }
 
+// LoadTaskClass reads a task class from its configuration repository without
+// registering it with any Manager, e.g. to check a workflow before deploying it.
+// The repository must already be present.
+func LoadTaskClass(taskClassIdentifier string) (class *TaskClass, err error) {
+	var taskClassList []*TaskClass
+	taskClassList, err = getTaskClassList([]string{taskClassIdentifier})
+	if err != nil {
+		return
+	}
+	if len(taskClassList) != 1 {
+		return nil, errors.New("cannot load task class " + taskClassIdentifier)
+	}
+	return taskClassList[0], nil
+}
+
 func (m *Manager) removeInactiveClasses() {
 
 	for taskClassIdentifier := range m.classes{
@@ -198,6 +227,23 @@ func (m *Manager) RefreshClasses(taskClassesRequired []string) (err error) {
 	return
 }
 
+// RefreshKnownClasses reloads all the task classes currently known to the Manager,
+// so that changes made since the workflows were loaded are picked up by tasks
+// deployed from now on.
+func (m *Manager) RefreshKnownClasses() (err error) {
+	m.mu.RLock()
+	taskClassesRequired := make([]string, 0, len(m.classes))
+	for taskClassIdentifier := range m.classes {
+		taskClassesRequired = append(taskClassesRequired, taskClassIdentifier)
+	}
+	m.mu.RUnlock()
+
+	if len(taskClassesRequired) == 0 {
+		return
+	}
+	return m.RefreshClasses(taskClassesRequired)
+}
+
 func (m *Manager) AcquireTasks(envId uuid.Array, taskDescriptors Descriptors) (err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -396,13 +442,7 @@ func (m *Manager) ConfigureTasks(envId uuid.Array, tasks Tasks) error {
 
 	m.mu.RLock()
 	// We generate a "bindMap" i.e. a map of the paths of registered inbound channels and their ports
-	bindMap := make(channel.BindMap)
-	for _, task := range tasks {
-		taskPath := task.parent.GetPath()
-		for inbChName, port := range task.GetBindPorts() {
-			bindMap[taskPath + ":" + inbChName] = channel.Endpoint{Host: task.GetHostname(), Port: port}
-		}
-	}
+	bindMap := tasks.BuildBindMap()
 	log.WithFields(logrus.Fields{"bindMap": pp.Sprint(bindMap), "envId": envId.String()}).
 		Debug("generated inbound bindMap for environment configuration")
 
@@ -418,7 +458,12 @@ func (m *Manager) ConfigureTasks(envId uuid.Array, tasks Tasks) error {
 	log.WithField("map", pp.Sprint(args)).Debug("pushing configuration to tasks")
 	m.mu.RUnlock()
 
-	cmd := controlcommands.NewMesosCommand_Transition(receivers, src, event, dest, args)
+	resolvedArgs, err := resolveSecrets(args)
+	if err != nil {
+		return err
+	}
+
+	cmd := controlcommands.NewMesosCommand_Transition(receivers, src, event, dest, resolvedArgs)
 	m.cq.Enqueue(cmd, notify)
 
 	response := <- notify
@@ -435,6 +480,89 @@ func (m *Manager) ConfigureTasks(envId uuid.Array, tasks Tasks) error {
 
 	// FIXME: improve error handling ↑
 
+	// We keep the arguments we just pushed, in case a task gets restarted by
+	// its executor and needs to be brought back to CONFIGURED. Secrets stay
+	// unresolved here, since these arguments may be stored or displayed.
+	m.mu.Lock()
+	for _, task := range tasks {
+		task.configureArgs = args[task.GetMesosCommandTarget()]
+	}
+	m.mu.Unlock()
+
+	return nil
+}
+
+// GetConfigureArgs returns, for each of the given tasks which was configured, a copy
+// of the arguments it received with its last CONFIGURE transition, keyed by task ID.
+func (m *Manager) GetConfigureArgs(tasks Tasks) (argsByTaskId map[string]controlcommands.PropertyMap) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	argsByTaskId = make(map[string]controlcommands.PropertyMap)
+	for _, task := range tasks {
+		if task == nil || task.configureArgs == nil {
+			continue
+		}
+		args := make(controlcommands.PropertyMap)
+		for k, v := range task.configureArgs {
+			args[k] = v
+		}
+		argsByTaskId[task.GetTaskId()] = args
+	}
+	return
+}
+
+// ReplayConfiguration pushes to a single task the same arguments it received
+// with its last CONFIGURE transition. It is meant to be used after a task
+// process was restarted by its executor and came back in STANDBY.
+func (m *Manager) ReplayConfiguration(taskId string) error {
+	m.mu.RLock()
+	task := m.roster.GetByTaskId(taskId)
+	if task == nil {
+		m.mu.RUnlock()
+		return fmt.Errorf("task %s not found in roster", taskId)
+	}
+	if task.configureArgs == nil {
+		m.mu.RUnlock()
+		return fmt.Errorf("task %s was never configured, nothing to replay", taskId)
+	}
+	receiver := task.GetMesosCommandTarget()
+	args := controlcommands.PropertyMapsMap{
+		receiver: make(controlcommands.PropertyMap),
+	}
+	for k, v := range task.configureArgs {
+		args[receiver][k] = v
+	}
+	m.mu.RUnlock()
+
+	log.WithFields(logrus.Fields{"taskId": taskId, "map": pp.Sprint(args)}).
+		Debug("replaying configuration to restarted task")
+
+	resolvedArgs, err := resolveSecrets(args)
+	if err != nil {
+		return err
+	}
+
+	notify := make(chan controlcommands.MesosCommandResponse)
+	cmd := controlcommands.NewMesosCommand_Transition(
+		[]controlcommands.MesosCommandTarget{receiver},
+		STANDBY.String(),
+		"CONFIGURE",
+		CONFIGURED.String(),
+		resolvedArgs)
+	m.cq.Enqueue(cmd, notify)
+
+	response := <- notify
+	close(notify)
+
+	if response == nil {
+		return errors.New("nil response")
+	}
+
+	errText := response.Err().Error()
+	if len(strings.TrimSpace(errText)) != 0 {
+		return errors.New(response.Err().Error())
+	}
 	return nil
 }
 
@@ -629,3 +757,19 @@ func (m *Manager) doKillTasks(tasks Tasks) (killed Tasks, running Tasks, err err
 
 	return
 }
+
+// resolveSecrets returns a copy of args with all secret references resolved,
+// ready to be pushed to the tasks.
+func resolveSecrets(args controlcommands.PropertyMapsMap) (resolvedArgs controlcommands.PropertyMapsMap, err error) {
+	resolvedArgs = make(controlcommands.PropertyMapsMap, len(args))
+	for receiver, propMap := range args {
+		var resolved map[string]string
+		resolved, err = secrets.ResolveMap(propMap)
+		if err != nil {
+			err = fmt.Errorf("task %s: %s", receiver.TaskId.Value, err.Error())
+			return nil, err
+		}
+		resolvedArgs[receiver] = resolved
+	}
+	return
+}
@@ -86,7 +86,10 @@ func (r Resources) Satisfy(wants *Wants) (bool) {
 		return false
 	}
 
-	wantsBindCount := len(wants.BindPorts)
+	wantsBindCount := 0
+	for _, ch := range wants.BindPorts {
+		wantsBindCount += ch.NumSockets
+	}
 	// if total ports minus what we use for static ranges is LESS than the number of dynamic ports we'll need...
 	if availPorts.Size() - wantsStaticRanges.Size() < uint64(wantsBindCount) {
 		return false
@@ -0,0 +1,37 @@
+package task
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("port ranges", func() {
+	It("should parse single ports and ranges", func() {
+		ranges, err := parsePortRanges(" 5555, 6000-6010 ,7000-7000")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ranges).To(Equal(Ranges{
+			{Begin: 5555, End: 5555},
+			{Begin: 6000, End: 6010},
+			{Begin: 7000, End: 7000},
+		}))
+	})
+
+	It("should use the second bound of a range as its end", func() {
+		// Regression: the end of a range used to be parsed from its begin
+		ranges, err := parsePortRanges("29000-29999")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ranges).To(HaveLen(1))
+		Expect(ranges[0].End).To(BeEquivalentTo(29999))
+	})
+
+	It("should return no ranges for an empty string", func() {
+		Expect(parsePortRanges("  ")).To(BeEmpty())
+	})
+
+	It("should fail on malformed ranges", func() {
+		_, err := parsePortRanges("1-2-3")
+		Expect(err).To(HaveOccurred())
+		_, err = parsePortRanges("http")
+		Expect(err).To(HaveOccurred())
+	})
+})
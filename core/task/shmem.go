@@ -0,0 +1,107 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package task
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/core/controlcommands"
+	"github.com/AliceO2Group/Control/core/task/channel"
+	"github.com/sirupsen/logrus"
+)
+
+// SHM_SEGMENT_SIZE_VAR is the variable which sets the size in bytes of the
+// FairMQ shared memory segment of an environment. It is resolved like any other
+// role variable, so it can be set in the task class defaults, in the workflow
+// or when creating the environment. If it isn't set, the devices use the
+// FairMQ default.
+const SHM_SEGMENT_SIZE_VAR = "shm_segment_size"
+
+// UsesShmem tells whether the task is a FairMQ device with at least one
+// channel on the shmem transport, either explicitly or through its transport
+// property.
+func (t Task) UsesShmem() bool {
+	class := t.GetTaskClass()
+	if class == nil || class.Control.Mode != controlmode.FAIRMQ {
+		return false
+	}
+	if transport, ok := class.Properties["transport"]; ok && transport == channel.SHMEM.String() {
+		return true
+	}
+	for _, inbCh := range class.Bind {
+		if inbCh.Transport == channel.SHMEM {
+			return true
+		}
+	}
+	if t.parent == nil {
+		return false
+	}
+	for _, outboundCh := range t.parent.CollectOutboundChannels() {
+		if outboundCh.Transport == channel.SHMEM {
+			return true
+		}
+	}
+	return false
+}
+
+// GetShmSession returns the FairMQ shared memory session ID of the task, which
+// is the same for all the tasks of an environment on a given host, or an empty
+// string if the task doesn't use the shmem transport.
+func (t Task) GetShmSession() string {
+	if t.parent == nil || len(t.hostname) == 0 || !t.UsesShmem() {
+		return ""
+	}
+	shortHostname := strings.SplitN(t.hostname, ".", 2)[0]
+	return "aliecs-" + t.GetEnvironmentId().String() + "-" + shortHostname
+}
+
+// buildShmPropertyMap returns the FairMQ properties which make the task join the
+// shared memory session of its environment on its host.
+func (t Task) buildShmPropertyMap() (pm controlcommands.PropertyMap) {
+	pm = make(controlcommands.PropertyMap)
+	session := t.GetShmSession()
+	if len(session) == 0 {
+		return
+	}
+	pm["session"] = session
+
+	segmentSizeVar, ok := t.parent.GetEffectiveVars()[SHM_SEGMENT_SIZE_VAR]
+	if !ok {
+		return
+	}
+	segmentSize := segmentSizeVar.Value
+	if _, err := strconv.ParseUint(segmentSize, 10, 64); err != nil {
+		log.WithFields(logrus.Fields{
+				"taskName": t.name,
+				"value": segmentSize,
+			}).
+			Error("invalid shared memory segment size, expecting a number of bytes")
+		return
+	}
+	pm["shm-segment-size"] = segmentSize
+	return
+}
@@ -44,6 +44,15 @@ var log = logger.New(logrus.StandardLogger(),"task")
 
 type VarMap map[string]string
 
+// ScopedVar is the effective value of a variable as seen from a role, along
+// with the layer which supplied it.
+type ScopedVar struct {
+	Value  string
+	Source string
+}
+
+type ScopedVarMap map[string]ScopedVar
+
 type parentRole interface {
 	UpdateStatus(Status)
 	UpdateState(State)
@@ -52,7 +61,7 @@ type parentRole interface {
 	SetTask(*Task)
 	GetEnvironmentId() uuid.Array
 	CollectOutboundChannels() []channel.Outbound
-	//GetVars()
+	GetEffectiveVars() ScopedVarMap
 }
 
 type Task struct {
@@ -66,11 +75,14 @@ type Task struct {
 	taskId       string
 	executorId   string
 
-	bindPorts    map[string]uint64
+	bindPorts    map[string][]uint64
 
 	status       Status
 	state        State
 
+	// last arguments pushed with CONFIGURE, kept for replay after a restart
+	configureArgs controlcommands.PropertyMap
+
 	GetTaskClass func() *TaskClass
 	// ↑ to be filled in by NewTaskForMesosOffer in Manager
 }
@@ -130,8 +142,15 @@ func (t Task) BuildTaskCommand() (cmd *common.TaskCommandInfo) {
 				"-S", "$CONTROL_OCCPLUGIN_ROOT/lib/",
 				"-P", "OCC",
 				"--color", "false")
+			// The executor cleans up the shared memory segments of the session
+			// once all of its tasks are gone
+			cmd.ShmSession = t.GetShmSession()
 		}
 		cmd.ControlMode = class.Control.Mode
+		cmd.Restart = class.Restart
+		if class.Control.Mode == controlmode.HOOK {
+			cmd.Hooks = class.Control.Hooks.Copy()
+		}
 	} else {
 		cmd = &common.TaskCommandInfo{}
 	}
@@ -194,7 +213,7 @@ func (t Task) GetEnvironmentId() uuid.Array {
 	return t.parent.GetEnvironmentId()
 }
 
-func (t Task) GetBindPorts() map[string]uint64 {
+func (t Task) GetBindPorts() map[string][]uint64 {
 	return t.bindPorts
 }
 
@@ -204,9 +223,15 @@ func (t Task) BuildPropertyMap(bindMap channel.BindMap) controlcommands.Property
 
 	propMap := make(controlcommands.PropertyMap)
 	if class := t.GetTaskClass(); class != nil {
+		// Secret references in the properties are kept as they are, they only get
+		// resolved right before being pushed to the task
+		for k, v := range class.Properties {
+			propMap[k] = v
+		}
+
 		if class.Control.Mode == controlmode.FAIRMQ {
 			for _, inbCh := range class.Bind {
-				port, ok := t.bindPorts[inbCh.Name]
+				endpoint, ok := bindMap[t.parent.GetPath() + ":" + inbCh.Name]
 				if !ok {
 					log.WithFields(logrus.Fields{
 							"channelName": inbCh.Name,
@@ -217,7 +242,7 @@ func (t Task) BuildPropertyMap(bindMap channel.BindMap) controlcommands.Property
 				}
 
 				// We get the FairMQ-formatted propertyMap from the inbound channel spec
-				chanProps := inbCh.ToFMQMap(port)
+				chanProps := inbCh.ToFMQMap(endpoint)
 
 				// And we copy it into the task's propertyMap
 				for k, v := range chanProps {
@@ -225,15 +250,26 @@ func (t Task) BuildPropertyMap(bindMap channel.BindMap) controlcommands.Property
 				}
 			}
 
+			for k, v := range t.buildShmPropertyMap() {
+				propMap[k] = v
+			}
+
 			for _, outboundCh := range t.parent.CollectOutboundChannels() {
 				// We get the FairMQ-formatted propertyMap from the outbound channel spec
 				chanProps := outboundCh.ToFMQMap(bindMap)
+				if len(chanProps) == 0 {
+					log.WithFields(logrus.Fields{
+							"channelName": outboundCh.Name,
+							"target": outboundCh.Target,
+							"taskName": t.name,
+						}).
+						Error("no inbound channel found for outbound channel target")
+					continue
+				}
 
 				// And if valid, we copy it into the task's propertyMap
-				if len(chanProps) > 0 {
-					for k, v := range chanProps {
-						propMap[k] = v
-					}
+				for k, v := range chanProps {
+					propMap[k] = v
 				}
 			}
 		}
@@ -27,6 +27,7 @@ package task
 import (
 	"fmt"
 
+	"github.com/AliceO2Group/Control/common/controlmode"
 	"github.com/AliceO2Group/Control/core/controlcommands"
 	"github.com/AliceO2Group/Control/core/task/channel"
 )
@@ -99,6 +100,63 @@ func (m Tasks) GetMesosCommandTargets() (receivers []controlcommands.MesosComman
 	return
 }
 
+// BuildBindMap maps the paths of the inbound channels of the tasks, in the
+// form role.path:channelName, to the endpoints they are bound to.
+// Inbound channels with the shmem transport are bound on ipc sockets rather
+// than on their ports if all the tasks which connect to them run on the same
+// host.
+func (m Tasks) BuildBindMap() (bindMap channel.BindMap) {
+	bindMap = make(channel.BindMap)
+	envIds := make(map[string]string)
+	for _, task := range m {
+		taskPath := task.parent.GetPath()
+		transports := make(map[string]channel.TransportType)
+		if class := task.GetTaskClass(); class != nil {
+			for _, inbCh := range class.Bind {
+				transports[inbCh.Name] = inbCh.Transport
+			}
+		}
+		for inbChName, ports := range task.GetBindPorts() {
+			bindPath := taskPath + ":" + inbChName
+			bindMap[bindPath] = channel.Endpoint{Host: task.GetHostname(), Ports: ports, Transport: transports[inbChName]}
+			envIds[bindPath] = task.GetEnvironmentId().String()
+		}
+	}
+
+	// Channels are only pushed to FairMQ tasks, so only their outbound channels
+	// count as peers
+	connected := make(map[string]bool)
+	remote := make(map[string]bool)
+	for _, task := range m {
+		class := task.GetTaskClass()
+		if class == nil || class.Control.Mode != controlmode.FAIRMQ {
+			continue
+		}
+		for _, outboundCh := range task.parent.CollectOutboundChannels() {
+			endpoint, ok := bindMap[outboundCh.Target]
+			if !ok {
+				continue
+			}
+			connected[outboundCh.Target] = true
+			if endpoint.Host != task.GetHostname() {
+				remote[outboundCh.Target] = true
+			}
+		}
+	}
+
+	for bindPath, endpoint := range bindMap {
+		if endpoint.Transport != channel.SHMEM || !connected[bindPath] || remote[bindPath] {
+			continue
+		}
+		endpoint.IpcPaths = make([]string, len(endpoint.Ports))
+		for i := range endpoint.IpcPaths {
+			endpoint.IpcPaths[i] = channel.IpcPath(envIds[bindPath], bindPath, i)
+		}
+		bindMap[bindPath] = endpoint
+	}
+	return
+}
+
 func (m Tasks) BuildPropertyMaps(bindMap channel.BindMap) (propMapMap controlcommands.PropertyMapsMap, err error) {
 	propMapMap = make(controlcommands.PropertyMapsMap)
 	for _, task := range m {
@@ -26,35 +26,98 @@ package workflow
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
+	"github.com/AliceO2Group/Control/core/repos"
 	"github.com/AliceO2Group/Control/core/task"
+	"github.com/sirupsen/logrus"
 )
 
 type aggregator struct {
 	Roles       []Role      `yaml:"roles,omitempty"`
+	pruned      []Role
 }
 
 func (r* aggregator) copy() copyable {
 	rCopy := aggregator{
 		Roles: make([]Role, len(r.Roles)),
+		pruned: make([]Role, len(r.pruned)),
 	}
 	for i, childRole := range r.Roles {
 		rCopy.Roles[i] = childRole.copy().(Role)
 	}
+	for i, childRole := range r.pruned {
+		rCopy.pruned[i] = childRole.copy().(Role)
+	}
 	return &rCopy
 }
 
+// enabledChecker is implemented by all roles which can have an enabled
+// expression, i.e. all roles except iterators, whose generated roles are
+// checked instead.
+type enabledChecker interface {
+	isEnabled(loadCtx *loadContext) (bool, error)
+}
+
+// processTemplates processes the templates of the child roles. Children
+// whose enabled expression evaluates to false are pruned: they are moved out
+// of Roles, so they don't generate any task, but they are kept aside to be
+// shown by role queries.
+func (r *aggregator) processTemplates(workflowRepo *repos.Repo, loadCtx *loadContext) (err error) {
+	roles := make([]Role, 0, len(r.Roles))
+	for _, role := range r.Roles {
+		if checker, ok := role.(enabledChecker); ok {
+			var enabled bool
+			enabled, err = checker.isEnabled(loadCtx)
+			if err != nil {
+				err = errors.New(fmt.Sprintf("cannot evaluate enabled expression of role %s: %s", role.GetPath(), err.Error()))
+				return
+			}
+			if !enabled {
+				log.WithFields(logrus.Fields{
+						"role": role.GetPath(),
+						"reason": role.GetPruneReason(),
+					}).
+					Debug("role disabled, pruning")
+				r.pruned = append(r.pruned, role)
+				continue
+			}
+		}
+
+		err = role.ProcessTemplates(workflowRepo, loadCtx)
+		if err != nil {
+			return
+		}
+		roles = append(roles, role)
+	}
+	r.Roles = roles
+
+	// Pruned roles are left out, so that alternative roles may share a name
+	// as long as only one of them is enabled
+	names := make(map[string]bool)
+	for _, role := range r.GetRoles() {
+		if names[role.GetName()] {
+			err = errors.New(fmt.Sprintf("role name collision: more than one role at %s", role.GetPath()))
+			return
+		}
+		names[role.GetName()] = true
+	}
+	return
+}
+
 // Auxiliary types for unmarshaling
 type _unionTypeProbe struct {
 	For *struct{}
 	Task *struct{}
 	Roles []interface{}
+	Include *string
 }
 type _roleUnion struct{
 	*iteratorRole
 	*aggregatorRole
 	*taskRole
+	*includeRole
 }
 
 func (union *_roleUnion) UnmarshalYAML(unmarshal func(interface{}) error) (unionErr error) {
@@ -67,10 +130,12 @@ func (union *_roleUnion) UnmarshalYAML(unmarshal func(interface{}) error) (union
 	switch {
 	case _probe.For != nil:
 		unionErr = unmarshal(&union.iteratorRole)
-	case _probe.Roles != nil && _probe.Task == nil:
+	case _probe.Roles != nil && _probe.Task == nil && _probe.Include == nil:
 		unionErr = unmarshal(&union.aggregatorRole)
-	case _probe.Task != nil && _probe.Roles == nil:
+	case _probe.Task != nil && _probe.Roles == nil && _probe.Include == nil:
 		unionErr = unmarshal(&union.taskRole)
+	case _probe.Include != nil && _probe.Roles == nil && _probe.Task == nil:
+		unionErr = unmarshal(&union.includeRole)
 	default:
 		unionErr = errors.New("cannot unmarshal invalid role to union")
 	}
@@ -99,6 +164,8 @@ func (a *aggregator) UnmarshalYAML(unmarshal func(interface{}) error) (err error
 			roles[i] = v.aggregatorRole
 		case v.taskRole != nil:
 			roles[i] = v.taskRole
+		case v.includeRole != nil:
+			roles[i] = v.includeRole
 		default:
 			err = errors.New("invalid child role at index " + strconv.Itoa(i))
 			return
@@ -152,6 +219,22 @@ func (r *aggregator) GetTaskClasses() (taskClassesSlice []string) {
 	return
 }
 
+// GetPrunedRoles returns the child roles which were disabled when the
+// workflow was loaded.
+func (r *aggregator) GetPrunedRoles() []Role {
+	if r == nil {
+		return nil
+	}
+	roles := make([]Role, 0)
+	for _, v := range r.Roles {
+		if iter, ok := v.(*iteratorRole); ok {
+			roles = append(roles, iter.GetPrunedRoles()...)
+		}
+	}
+	roles = append(roles, r.pruned...)
+	return roles
+}
+
 func (r *aggregator) GetRoles() []Role {
 	if r == nil {
 		return nil
@@ -41,7 +41,10 @@ func (r *aggregatorRole) UnmarshalYAML(unmarshal func(interface{}) error) (err e
 	// NOTE: see NOTE in roleBase.UnmarshalYAML
 	type _roleBase roleBase
 
-	role_base := _roleBase{}
+	role_base := _roleBase{
+		status: SafeStatus{status:task.INACTIVE},
+		state:  SafeState{state:task.STANDBY},
+	}
 	err = unmarshal(&role_base)
 	if err != nil {
 		return
@@ -77,19 +80,14 @@ func (r *aggregatorRole) GlobFilter(g glob.Glob) (rs []Role) {
 }
 
 
-func (r *aggregatorRole) ProcessTemplates(workflowRepo *repos.Repo) (err error) {
+func (r *aggregatorRole) ProcessTemplates(workflowRepo *repos.Repo, loadCtx *loadContext) (err error) {
 	if r == nil {
 		return errors.New("role tree error when processing templates")
 	}
 
 	r.resolveOutboundChannelTargets()
 
-	for _, role := range r.Roles {
-		err = role.ProcessTemplates(workflowRepo)
-		if err != nil {
-			return
-		}
-	}
+	err = r.processTemplates(workflowRepo, loadCtx)
 	return
 }
 
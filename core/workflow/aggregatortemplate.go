@@ -97,6 +97,9 @@ func (at *aggregatorTemplate) generateRole(t templateMap) (c Role, err error) {
 	}
 
 	// 3 + 4)
+	for _, v := range ar.Roles {
+		v.setParent(&ar)
+	}
 	c = &ar
 	return
 }
\ No newline at end of file
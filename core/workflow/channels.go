@@ -0,0 +1,123 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"fmt"
+
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/task/channel"
+)
+
+type boundChannel struct {
+	role    *taskRole
+	inbound channel.Inbound
+}
+
+// checkChannelTopology matches the outbound channels of the given task roles
+// against the inbound channels their classes bind, the same way the task
+// manager builds its bind map when configuring an environment.
+// Each outbound target must resolve to exactly one inbound channel on another
+// role, with a compatible socket type and transport. classes maps task class identifiers to
+// their loaded task class, roles whose class is missing are skipped.
+func checkChannelTopology(taskRoles []*taskRole, classes map[string]*task.TaskClass) (problems []string) {
+	problems = make([]string, 0)
+
+	binds := make(map[string][]boundChannel)
+	for _, t := range taskRoles {
+		class, ok := classes[t.GetTaskClass()]
+		if !ok {
+			continue
+		}
+		for _, inbCh := range class.Bind {
+			bindPath := t.GetPath() + ":" + inbCh.Name
+			binds[bindPath] = append(binds[bindPath], boundChannel{role: t, inbound: inbCh})
+		}
+	}
+
+	// An outbound channel declared on an aggregator is inherited by all the
+	// tasks below it, so we only report each problem once
+	reported := make(map[string]bool)
+	for _, t := range taskRoles {
+		class, ok := classes[t.GetTaskClass()]
+		// Channels are only pushed to FairMQ tasks
+		if !ok || class.Control.Mode != controlmode.FAIRMQ {
+			continue
+		}
+		for _, outboundCh := range t.CollectOutboundChannels() {
+			if outboundCh.HasExplicitTarget() {
+				continue
+			}
+
+			var problem string
+			matches := binds[outboundCh.Target]
+			switch {
+			case isTemplated(outboundCh.Target):
+				problem = fmt.Sprintf("unresolved template in target %s of outbound channel %s",
+					outboundCh.Target, outboundCh.Name)
+			case len(matches) == 0:
+				problem = fmt.Sprintf("target %s of outbound channel %s matches no inbound channel",
+					outboundCh.Target, outboundCh.Name)
+			case len(matches) > 1:
+				problem = fmt.Sprintf("target %s of outbound channel %s matches %d inbound channels",
+					outboundCh.Target, outboundCh.Name, len(matches))
+			case matches[0].role == t:
+				problem = fmt.Sprintf("outbound channel %s connects to inbound channel %s of the same role",
+					outboundCh.Name, outboundCh.Target)
+			case !outboundCh.Type.IsCompatible(matches[0].inbound.Type):
+				problem = fmt.Sprintf("outbound channel %s of type %s cannot connect to inbound channel %s of type %s",
+					outboundCh.Name, outboundCh.Type.String(), outboundCh.Target, matches[0].inbound.Type.String())
+			case !outboundCh.Transport.IsCompatible(matches[0].inbound.Transport):
+				problem = fmt.Sprintf("outbound channel %s with transport %s cannot connect to inbound channel %s with transport %s",
+					outboundCh.Name, outboundCh.Transport.String(), outboundCh.Target, matches[0].inbound.Transport.String())
+			default:
+				continue
+			}
+			if reported[problem] {
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("role %s: %s", t.GetPath(), problem))
+			reported[problem] = true
+		}
+	}
+	return
+}
+
+// collectTaskRoles returns all the enabled task roles in a workflow.
+func collectTaskRoles(root Role) (taskRoles []*taskRole) {
+	taskRoles = make([]*taskRole, 0)
+	var walk func(role Role)
+	walk = func(role Role) {
+		if t, ok := role.(*taskRole); ok {
+			taskRoles = append(taskRoles, t)
+		}
+		for _, child := range role.GetRoles() {
+			walk(child)
+		}
+	}
+	walk(root)
+	return
+}
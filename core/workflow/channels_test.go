@@ -0,0 +1,161 @@
+package workflow
+
+import (
+	"strings"
+
+	"github.com/AliceO2Group/Control/core/task"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+// testClasses maps the task class identifiers of the task roles of root to
+// the classes built from classYaml, which is keyed by bare class name.
+func testClasses(root Role, classYaml map[string]string) map[string]*task.TaskClass {
+	classes := make(map[string]*task.TaskClass)
+	for _, t := range collectTaskRoles(root) {
+		identifier := t.GetTaskClass()
+		name := strings.SplitN(strings.SplitN(identifier, "tasks/", 2)[1], "@", 2)[0]
+		doc, ok := classYaml[name]
+		if !ok {
+			continue
+		}
+		class := new(task.TaskClass)
+		Expect(yaml.Unmarshal([]byte(doc), class)).To(Succeed())
+		classes[identifier] = class
+	}
+	return classes
+}
+
+var _ = Describe("channel topology", func() {
+	const (
+		sender = `name: sender
+control:
+  mode: fairmq
+`
+		receiver = `name: receiver
+control:
+  mode: fairmq
+bind:
+  - name: data
+    type: pull
+    transport: shmem
+`
+		basic = `name: basic
+control:
+  mode: basic
+`
+	)
+
+	check := func(doc string, classYaml map[string]string) []string {
+		root, err := loadTestWorkflow(doc, &loadContext{})
+		Expect(err).NotTo(HaveOccurred())
+		return checkChannelTopology(collectTaskRoles(root), testClasses(root, classYaml))
+	}
+	classYaml := map[string]string{"sender": sender, "receiver": receiver, "basic": basic}
+
+	It("should accept outbound channels which match one compatible inbound channel", func() {
+		Expect(check(`name: root
+roles:
+  - name: a
+    connect:
+      - name: out
+        type: push
+        target: "{{ parent }}.b:data"
+    task:
+      load: sender
+  - name: b
+    task:
+      load: receiver
+`, classYaml)).To(BeEmpty())
+	})
+
+	It("should report targets which match no inbound channel", func() {
+		Expect(check(`name: root
+roles:
+  - name: a
+    connect:
+      - name: out
+        type: push
+        target: "{{ parent }}.b:nope"
+    task:
+      load: sender
+  - name: b
+    task:
+      load: receiver
+`, classYaml)).To(ConsistOf("role root.a: target root.b:nope of outbound channel out matches no inbound channel"))
+	})
+
+	It("should report incompatible socket types and loops on the same role", func() {
+		Expect(check(`name: root
+roles:
+  - name: a
+    connect:
+      - name: out
+        type: pull
+        target: "{{ parent }}.b:data"
+    task:
+      load: sender
+  - name: b
+    connect:
+      - name: self
+        type: push
+        target: "{{ parent }}.b:data"
+    task:
+      load: receiver
+`, classYaml)).To(ConsistOf(
+			"role root.a: outbound channel out of type pull cannot connect to inbound channel root.b:data of type pull",
+			"role root.b: outbound channel self connects to inbound channel root.b:data of the same role",
+		))
+	})
+
+	It("should report incompatible transports", func() {
+		Expect(check(`name: root
+roles:
+  - name: a
+    connect:
+      - name: out
+        type: push
+        transport: zeromq
+        target: "{{ parent }}.b:data"
+    task:
+      load: sender
+  - name: b
+    task:
+      load: receiver
+`, classYaml)).To(ConsistOf(
+			"role root.a: outbound channel out with transport zeromq cannot connect to inbound channel root.b:data with transport shmem",
+		))
+	})
+
+	It("should report an outbound channel inherited by several tasks only once", func() {
+		Expect(check(`name: root
+roles:
+  - name: senders
+    connect:
+      - name: out
+        type: push
+        target: "{{ up 1 }}.nowhere:data"
+    roles:
+      - name: a
+        task:
+          load: sender
+      - name: b
+        task:
+          load: sender
+`, classYaml)).To(ConsistOf("role root.senders.a: target root.nowhere:data of outbound channel out matches no inbound channel"))
+	})
+
+	It("should skip tasks which are not controlled through FairMQ", func() {
+		Expect(check(`name: root
+roles:
+  - name: a
+    connect:
+      - name: out
+        type: push
+        target: "{{ parent }}.nowhere:data"
+    task:
+      load: basic
+`, classYaml)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,70 @@
+package workflow
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("enabled expressions", func() {
+	It("should prune disabled roles and their children, and keep their reason", func() {
+		root, err := loadTestWorkflow(`name: root
+vars:
+  with_qc: "false"
+roles:
+  - name: readout
+    task:
+      load: readout
+  - name: qc
+    enabled: "{{ .with_qc }}"
+    roles:
+      - name: checker
+        task:
+          load: qc-checker
+  - name: stfb
+    enabled: "false"
+    task:
+      load: stfbuilder
+`, &loadContext{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleNames(root.GetRoles())).To(Equal([]string{"readout"}))
+		Expect(roleNames(root.GetPrunedRoles())).To(Equal([]string{"qc", "stfb"}))
+		Expect(root.GetPrunedRoles()[0].GetPruneReason()).To(Equal("enabled: {{ .with_qc }} evaluated to false"))
+		Expect(root.GetPrunedRoles()[1].GetPruneReason()).To(Equal("enabled: false"))
+		Expect(root.GetTaskClasses()).To(HaveLen(1))
+	})
+
+	It("should let user variables override role variables", func() {
+		root, err := loadTestWorkflow(`name: root
+vars:
+  with_qc: "false"
+roles:
+  - name: qc
+    enabled: "{{ .with_qc }}"
+    task:
+      load: qc-checker
+`, &loadContext{userVars: map[string]string{"with_qc": "true"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleNames(root.GetRoles())).To(Equal([]string{"qc"}))
+		Expect(root.GetPrunedRoles()).To(BeEmpty())
+	})
+
+	It("should fail on expressions which are not booleans or use unknown variables", func() {
+		_, err := loadTestWorkflow(`name: root
+roles:
+  - name: qc
+    enabled: "maybe"
+    task:
+      load: qc-checker
+`, &loadContext{})
+		Expect(err).To(MatchError(ContainSubstring("expecting true or false")))
+
+		_, err = loadTestWorkflow(`name: root
+roles:
+  - name: qc
+    enabled: "{{ .nope }}"
+    task:
+      load: qc-checker
+`, &loadContext{})
+		Expect(err).To(MatchError(ContainSubstring("cannot evaluate enabled expression of role root.qc")))
+	})
+})
@@ -0,0 +1,212 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AliceO2Group/Control/core/repos"
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/the"
+	"github.com/gobwas/glob"
+	"github.com/sirupsen/logrus"
+)
+
+// includeRole grafts the tree of another workflow template under its own
+// path. The included workflow is specified as a workflow path, e.g.
+// github.com/AliceO2Group/ControlWorkflows/workflows/readout@v0.1.0, or
+// simply readout to include a workflow from the same repo as the including
+// one. The vars, constraints and outbound channels of the include role
+// override the ones of the root of the included workflow.
+type includeRole struct {
+	aggregatorRole
+	Include          string                  `yaml:"include"`
+	includedRevision string
+}
+
+func (i *includeRole) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
+	// The children of an include role come from the included workflow, so we
+	// only read the fields of roleBase, in place
+	err = unmarshal(&i.roleBase)
+	if err != nil {
+		return
+	}
+
+	aux := struct{
+		Include string `yaml:"include"`
+	}{}
+	err = unmarshal(&aux)
+	if err != nil {
+		return
+	}
+	if len(strings.TrimSpace(aux.Include)) == 0 {
+		err = errors.New("include role must specify a workflow to include")
+		return
+	}
+
+	i.aggregator = aggregator{Roles: make([]Role, 0)}
+	i.Include = strings.TrimSpace(aux.Include)
+	i.includedRevision = ""
+	return
+}
+
+func (i *includeRole) GlobFilter(g glob.Glob) (rs []Role) {
+	rs = make([]Role, 0)
+	if g.Match(i.GetPath()) {
+		rs = append(rs, i)
+	}
+	for _, chr := range i.Roles {
+		chrs := chr.GlobFilter(g)
+		if len(chrs) != 0 {
+			rs = append(rs, chrs...)
+		}
+	}
+	return
+}
+
+// ProcessTemplates loads the included workflow, grafts it under this role
+// and processes its templates against the repo it comes from.
+func (i *includeRole) ProcessTemplates(workflowRepo *repos.Repo, loadCtx *loadContext) (err error) {
+	if i == nil {
+		return errors.New("role tree error when processing templates")
+	}
+
+	includePath := i.Include
+	if !strings.Contains(includePath, "workflows/") {
+		// A bare workflow name refers to the repo of the including workflow
+		includePath = workflowRepo.GetIdentifier() + "workflows/" + includePath
+	}
+
+	// GetWorkflow leaves the included repo on the revision the include is
+	// pinned to, so we put it back once we're done with the included tree,
+	// otherwise the rest of the including workflow would be resolved against
+	// the wrong revision. The task classes of the included tree are resolved
+	// against the pinned hash before that, and the task manager reads them at
+	// that hash regardless of what is checked out.
+	repoManager := the.RepoManager()
+	includedRepoId := strings.SplitN(includePath, "workflows/", 2)[0]
+	if includedRepo, ok := repoManager.GetRepos()[includedRepoId]; ok {
+		previousRevision := includedRepo.Revision
+		defer func() {
+			if includedRepo.Revision == previousRevision {
+				return
+			}
+			restoreErr := repoManager.CheckoutRevision(includedRepo, previousRevision)
+			if restoreErr != nil {
+				log.WithFields(logrus.Fields{
+						"repo": includedRepoId,
+						"revision": previousRevision,
+					}).
+					WithError(restoreErr).
+					Error("cannot restore repo revision after workflow include")
+				if err == nil {
+					err = restoreErr
+				}
+			}
+		}()
+	}
+
+	var included *aggregatorRole
+	var includedRepo *repos.Repo
+	included, includedRepo, i.includedRevision, err = loadWorkflowFile(includePath, i)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("cannot include workflow %s in role %s: %s", i.Include, i.GetPath(), err.Error()))
+		return
+	}
+
+	err = i.checkIncludeCycle()
+	if err != nil {
+		return
+	}
+
+	i.graft(included)
+	i.resolveOutboundChannelTargets()
+
+	err = i.processTemplates(includedRepo, loadCtx)
+	if err != nil {
+		return
+	}
+	log.WithFields(logrus.Fields{
+			"role": i.GetPath(),
+			"include": i.includedRevision,
+		}).
+		Debug("workflow included")
+	return
+}
+
+// checkIncludeCycle fails if the workflow included by this role is also
+// included by one of its ancestors.
+func (i *includeRole) checkIncludeCycle() error {
+	chain := []string{i.includedRevision}
+	for p := i.GetParent(); p != nil; p = p.GetParent() {
+		parentInclude, ok := p.(*includeRole)
+		if !ok {
+			continue
+		}
+		chain = append([]string{parentInclude.includedRevision}, chain...)
+		if parentInclude.includedRevision == i.includedRevision {
+			return errors.New(fmt.Sprintf("include cycle detected in role %s: %s",
+				i.GetPath(), strings.Join(chain, " -> ")))
+		}
+	}
+	return nil
+}
+
+// graft moves the children of the included workflow root under this role,
+// merging the root's vars, constraints and outbound channels with ours.
+func (i *includeRole) graft(included *aggregatorRole) {
+	vars := make(task.VarMap)
+	for k, v := range included.Vars {
+		vars[k] = v
+	}
+	for k, v := range i.Vars {
+		vars[k] = v
+	}
+	i.Vars = vars
+
+	i.Constraints = i.Constraints.MergeParent(included.Constraints)
+	i.Connect = append(included.Connect, i.Connect...)
+
+	i.Roles = included.Roles
+	for _, v := range i.Roles {
+		v.setParent(i)
+	}
+}
+
+func (i *includeRole) copy() copyable {
+	rCopy := &includeRole{
+		Include: i.Include,
+		includedRevision: i.includedRevision,
+	}
+	i.roleBase.copyTo(&rCopy.roleBase)
+	rCopy.aggregator = *i.aggregator.copy().(*aggregator)
+	return rCopy
+}
+
+func (i *includeRole) setParent(role Updatable) {
+	i.parent = role
+}
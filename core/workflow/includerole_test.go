@@ -0,0 +1,66 @@
+package workflow
+
+import (
+	"github.com/AliceO2Group/Control/core/task"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("include roles", func() {
+	var root *aggregatorRole
+
+	BeforeEach(func() {
+		root = new(aggregatorRole)
+		Expect(yaml.Unmarshal([]byte(`name: root
+roles:
+  - name: readout
+    include: " readout-flp "
+    vars:
+      x: "1"
+`), root)).To(Succeed())
+	})
+
+	It("should be unmarshalled as include roles", func() {
+		Expect(root.GetRoles()).To(HaveLen(1))
+		include, ok := root.GetRoles()[0].(*includeRole)
+		Expect(ok).To(BeTrue())
+		Expect(include.Include).To(Equal("readout-flp"))
+		Expect(include.GetRoles()).To(BeEmpty())
+		Expect(include.GetStatus()).To(BeEquivalentTo(task.INACTIVE))
+		Expect(include.GetPath()).To(Equal("root.readout"))
+	})
+
+	It("should fail without a workflow to include", func() {
+		Expect(yaml.Unmarshal([]byte(`name: root
+roles:
+  - name: readout
+    include: ""
+`), new(aggregatorRole))).NotTo(Succeed())
+	})
+
+	It("should copy the included workflow and vars", func() {
+		include := root.GetRoles()[0].(*includeRole)
+		include.includedRevision = "github.com/o2/wf/workflows/readout-flp@abc"
+		rCopy := include.copy().(*includeRole)
+		Expect(rCopy.Include).To(Equal(include.Include))
+		Expect(rCopy.includedRevision).To(Equal(include.includedRevision))
+		Expect(rCopy.GetStatus()).To(BeEquivalentTo(task.INACTIVE))
+
+		rCopy.Vars["x"] = "2"
+		Expect(include.Vars).To(HaveKeyWithValue("x", "1"))
+	})
+
+	It("should detect include cycles", func() {
+		outer := root.GetRoles()[0].(*includeRole)
+		outer.includedRevision = "github.com/o2/wf/workflows/readout-flp@abc"
+		Expect(outer.checkIncludeCycle()).To(Succeed())
+
+		inner := &includeRole{Include: "readout-flp"}
+		inner.Name = "again"
+		inner.includedRevision = outer.includedRevision
+		outer.Roles = []Role{inner}
+		inner.setParent(outer)
+		Expect(inner.checkIncludeCycle()).To(MatchError(ContainSubstring("include cycle detected in role root.readout.again")))
+	})
+})
@@ -26,9 +26,12 @@ package workflow
 
 import (
 	"errors"
+	"fmt"
 	"github.com/AliceO2Group/Control/core/repos"
+	"sort"
 	"strconv"
 
+	"github.com/AliceO2Group/Control/configuration"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/task/constraint"
 	"github.com/gobwas/glob"
@@ -42,6 +45,10 @@ type iteratorRole struct {
 
 type templateMap map[string]interface{}
 
+type varSetter interface {
+	setVar(key string, value string)
+}
+
 type roleTemplate interface {
 	Role
 	generateRole(t templateMap) (Role, error)
@@ -79,46 +86,169 @@ func (i *iteratorRole) UnmarshalYAML(unmarshal func(interface{}) error) (err err
 
 	role.template = template
 	role.For = auxFor.For
+	role.Roles = make([]Role, 0)
 
-	// FIXME: if Name does not contain {{ }}, we must bail!
-
-	err = role.expandTemplate()
-	if err != nil {
+	// A name without template would be the same for all generated roles
+	if !isTemplated(template.GetName()) {
+		err = errors.New(fmt.Sprintf("name %s of iterator role must be a template, e.g. %s{{ .%s }}",
+			template.GetName(), template.GetName(), role.For.Var))
 		return
 	}
+
+	// The template is only expanded in ProcessTemplates, since the values
+	// we iterate over may come from the configuration or from Mesos.
 	*i = role
 	return
 }
 
+type iteratorKind int
+const (
+	iterateRange iteratorKind = iota
+	iterateValues
+	iterateConfig
+	iterateAgents
+)
+
+// iteratorInfo describes what an iteratorRole loops over: either an integer
+// range from begin to end (inclusive), a literal list of values, the keys
+// found under a configuration prefix, or the hostnames of the Mesos agents
+// which satisfy some constraints. The current value is bound to var.
 type iteratorInfo struct {
-	Begin       int                     `yaml:"begin"`
-	End         int                     `yaml:"end"`
-	Var         string                  `yaml:"var"`
+	Begin        int                     `yaml:"begin,omitempty"`
+	End          int                     `yaml:"end,omitempty"`
+	Values       []string                `yaml:"values,omitempty"`
+	ConfigPrefix string                  `yaml:"configPrefix,omitempty"`
+	Agents       constraint.Constraints  `yaml:"agents,omitempty"`
+	Var          string                  `yaml:"var"`
+	kind         iteratorKind
 }
 
 func (f *iteratorInfo) UnmarshalYAML(unmarshal func(interface{}) error) (err error) {
 	aux := struct{
-		Begin       string                  `yaml:"begin"`
-		End         string                  `yaml:"end"`
-		Var         string                  `yaml:"var"`
+		Begin        *string                 `yaml:"begin"`
+		End          *string                 `yaml:"end"`
+		Values       []string                `yaml:"values"`
+		ConfigPrefix *string                 `yaml:"configPrefix"`
+		Agents       *constraint.Constraints `yaml:"agents"`
+		Var          string                  `yaml:"var"`
 	}{}
 	err = unmarshal(&aux)
 	if err != nil {
 		return
 	}
 
-	f.Begin, err = strconv.Atoi(aux.Begin)
-	if err != nil {
-		return
+	sources := 0
+	if aux.Begin != nil || aux.End != nil {
+		sources++
+		f.kind = iterateRange
+		if aux.Begin == nil || aux.End == nil {
+			return errors.New("iterator range needs both begin and end")
+		}
+		f.Begin, err = strconv.Atoi(*aux.Begin)
+		if err != nil {
+			return
+		}
+		f.End, err = strconv.Atoi(*aux.End)
+		if err != nil {
+			return
+		}
 	}
-	f.End, err = strconv.Atoi(aux.End)
-	if err != nil {
-		return
+	if aux.Values != nil {
+		sources++
+		f.kind = iterateValues
+		f.Values = aux.Values
+	}
+	if aux.ConfigPrefix != nil {
+		sources++
+		f.kind = iterateConfig
+		f.ConfigPrefix = *aux.ConfigPrefix
+	}
+	if aux.Agents != nil {
+		sources++
+		f.kind = iterateAgents
+		f.Agents = *aux.Agents
 	}
+	if sources != 1 {
+		return errors.New("iterator needs exactly one of begin/end, values, configPrefix or agents")
+	}
+
 	f.Var = aux.Var
 	return
 }
 
+// values returns the values the iterator loops over, in order.
+func (f *iteratorInfo) values(loadCtx *loadContext) (values []string, err error) {
+	switch f.kind {
+	case iterateRange:
+		values = make([]string, 0)
+		for j := f.Begin; j <= f.End; j++ {
+			values = append(values, strconv.Itoa(j))
+		}
+	case iterateValues:
+		values = make([]string, len(f.Values))
+		copy(values, f.Values)
+	case iterateConfig:
+		if loadCtx == nil || loadCtx.cfg == nil {
+			return nil, errors.New("no configuration available to iterate over " + f.ConfigPrefix)
+		}
+		var item configuration.Item
+		item, err = loadCtx.cfg.GetRecursive(f.ConfigPrefix)
+		if err != nil {
+			return
+		}
+		values, err = configItemValues(item)
+		if err != nil {
+			err = errors.New(fmt.Sprintf("cannot iterate over configuration prefix %s: %s", f.ConfigPrefix, err.Error()))
+			return
+		}
+	case iterateAgents:
+		if loadCtx == nil || loadCtx.agentCache == nil {
+			return nil, errors.New("no Mesos agents available to iterate over")
+		}
+		values = make([]string, 0)
+		for _, agent := range loadCtx.agentCache.Filter(f.Agents) {
+			values = append(values, agent.Hostname)
+		}
+	}
+	return
+}
+
+// configItemValues returns the sorted keys of a configuration map, or the
+// indices of a configuration array. YAML based sources return lists as arrays
+// while Consul returns them as maps keyed by index, so maps whose keys are all
+// indices are sorted numerically for both to yield the same keys in the same order.
+func configItemValues(item configuration.Item) (values []string, err error) {
+	if item == nil {
+		return make([]string, 0), nil
+	}
+	switch item.Type() {
+	case configuration.IT_Map:
+		values = make([]string, 0, len(item.Map()))
+		indices := make(map[string]int, len(item.Map()))
+		for k := range item.Map() {
+			values = append(values, k)
+			if idx, convErr := strconv.Atoi(k); convErr == nil && idx >= 0 {
+				indices[k] = idx
+			}
+		}
+		if len(indices) == len(values) {
+			sort.Slice(values, func(a, b int) bool {
+				return indices[values[a]] < indices[values[b]]
+			})
+		} else {
+			sort.Strings(values)
+		}
+	case configuration.IT_Array:
+		values = make([]string, 0, len(item.Array()))
+		for idx := range item.Array() {
+			values = append(values, strconv.Itoa(idx))
+		}
+	default:
+		err = errors.New("not a map or an array")
+	}
+	return
+}
+
 func (i *iteratorRole) GlobFilter(g glob.Glob) (rs []Role) {
 	rs = make([]Role, 0)
 	for _, chr := range i.Roles {
@@ -130,32 +260,54 @@ func (i *iteratorRole) GlobFilter(g glob.Glob) (rs []Role) {
 	return
 }
 
-func (i *iteratorRole) ProcessTemplates(workflowRepo *repos.Repo) (err error) {
+func (i *iteratorRole) ProcessTemplates(workflowRepo *repos.Repo, loadCtx *loadContext) (err error) {
 	if i == nil {
 		return errors.New("role tree error when processing templates")
 	}
 
-	for _, role := range i.Roles {
-		err = role.ProcessTemplates(workflowRepo)
-		if err != nil {
-			return
-		}
+	err = i.expandTemplate(loadCtx)
+	if err != nil {
+		return
 	}
+
+	err = i.processTemplates(workflowRepo, loadCtx)
 	return
 }
 
-func (i *iteratorRole) expandTemplate() (err error) {
-	values := make(templateMap)
+func (i *iteratorRole) expandTemplate(loadCtx *loadContext) (err error) {
+	var forValues []string
+	forValues, err = i.For.values(loadCtx)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("cannot expand iterator %s: %s", i.GetPath(), err.Error()))
+		return
+	}
+
+	// The variables of enclosing roles, including the variables of enclosing
+	// iterators, are in scope when generating roles
+	values := loadCtx.templateValues(i.template.GetVars())
 
 	roles := make([]Role, 0)
+	names := make(map[string]string)
 
-	for j := i.For.Begin; j <= i.For.End; j++ {
-		values[i.For.Var] = strconv.Itoa(j)
+	for _, value := range forValues {
+		values[i.For.Var] = value
 		var newRole Role
 		newRole, err = i.template.generateRole(values)
 		if err != nil {
 			return
 		}
+		if otherValue, exists := names[newRole.GetName()]; exists {
+			err = errors.New(fmt.Sprintf("iterator %s generates role %s twice, for %s=%s and %s=%s",
+				i.GetPath(), newRole.GetName(), i.For.Var, otherValue, i.For.Var, value))
+			return
+		}
+		names[newRole.GetName()] = value
+		newRole.setParent(i.GetParent())
+		// The iterator variable is visible to the generated role, e.g. for
+		// its enabled expression
+		if vs, ok := newRole.(varSetter); ok {
+			vs.setVar(i.For.Var, value)
+		}
 		roles = append(roles, newRole)
 	}
 
@@ -191,12 +343,65 @@ func (i *iteratorRole) GetPath() string {
 	return i.template.GetPath()
 }
 
+func (i *iteratorRole) GetVars() task.VarMap {
+	if i == nil || i.template == nil {
+		return nil
+	}
+	return i.template.GetVars()
+}
+
+func (i *iteratorRole) GetEffectiveVars() task.ScopedVarMap {
+	if i == nil || i.template == nil {
+		return nil
+	}
+	return i.template.GetEffectiveVars()
+}
+
+func (i *iteratorRole) getRoleVars() task.ScopedVarMap {
+	if i == nil || i.template == nil {
+		return nil
+	}
+	return i.template.getRoleVars()
+}
+
+func (i *iteratorRole) getGlobalVars() map[string]string {
+	if i == nil || i.template == nil {
+		return nil
+	}
+	return i.template.getGlobalVars()
+}
+
+// GetPruneReason returns an empty string, since iterators themselves are
+// never pruned, only the roles they generate.
+func (i *iteratorRole) GetPruneReason() string {
+	return ""
+}
+
 func (i *iteratorRole) GetStatus() task.Status {
-	panic("implement me")
+	if i == nil {
+		return task.UNDEFINED
+	}
+	return aggregateStatus(i.GetRoles())
 }
 
 func (i *iteratorRole) GetState() task.State {
-	panic("implement me")
+	if i == nil {
+		return task.UNKNOWN
+	}
+	return aggregateState(i.GetRoles())
+}
+
+func (i *iteratorRole) copy() copyable {
+	rCopy := iteratorRole{
+		aggregator: *i.aggregator.copy().(*aggregator),
+		For: i.For,
+		template: i.template.copy().(roleTemplate),
+	}
+	rCopy.For.Values = make([]string, len(i.For.Values))
+	copy(rCopy.For.Values, i.For.Values)
+	rCopy.For.Agents = make(constraint.Constraints, len(i.For.Agents))
+	copy(rCopy.For.Agents, i.For.Agents)
+	return &rCopy
 }
 
 func (i *iteratorRole) setParent(role Updatable) {
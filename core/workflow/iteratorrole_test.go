@@ -0,0 +1,205 @@
+package workflow
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AliceO2Group/Control/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("iterator roles", func() {
+	It("should give each generated role its own loop variable", func() {
+		root, err := loadTestWorkflow(`name: root
+roles:
+  - name: "link{{ .j }}"
+    for:
+      values: [a, b]
+      var: j
+    vars:
+      x: "1"
+    enabled: '{{ eq .j "a" }}'
+    task:
+      load: readout
+`, &loadContext{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleNames(root.GetRoles())).To(Equal([]string{"linka"}))
+		Expect(roleNames(root.GetPrunedRoles())).To(Equal([]string{"linkb"}))
+		Expect(root.GetRoles()[0].GetVars()).To(HaveKeyWithValue("j", "a"))
+		Expect(root.GetRoles()[0].GetVars()).To(HaveKeyWithValue("x", "1"))
+		Expect(root.GetPrunedRoles()[0].GetVars()).To(HaveKeyWithValue("j", "b"))
+	})
+})
+
+// linksYaml returns n link entries, as a YAML array or, like Consul returns
+// lists, as a map keyed by index in reverse order.
+func linksYaml(n int, asMap bool) []byte {
+	var b strings.Builder
+	for idx := n - 1; idx >= 0; idx-- {
+		if asMap {
+			fmt.Fprintf(&b, "\"%d\": {host: flp%d}\n", idx, idx)
+		} else {
+			fmt.Fprintf(&b, "- host: flp%d\n", n-1-idx)
+		}
+	}
+	return []byte(b.String())
+}
+
+var _ = Describe("configuration prefix iterators", func() {
+	It("should iterate over the indices of arrays and the keys of maps", func() {
+		array, err := configuration.ItemFromYaml([]byte(`
+- host: flp1
+- host: flp2
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configItemValues(array)).To(Equal([]string{"0", "1"}))
+
+		m, err := configuration.ItemFromYaml([]byte(`
+"1": {host: flp2}
+"0": {host: flp1}
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configItemValues(m)).To(Equal([]string{"0", "1"}))
+
+		m, err = configuration.ItemFromYaml([]byte(`
+b10: {host: flp2}
+b2: {host: flp1}
+a: {host: flp0}
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configItemValues(m)).To(Equal([]string{"a", "b10", "b2"}))
+	})
+
+	It("should yield the same order for arrays and maps keyed by index", func() {
+		expected := make([]string, 0)
+		for idx := 0; idx < 12; idx++ {
+			expected = append(expected, fmt.Sprintf("%d", idx))
+		}
+
+		array, err := configuration.ItemFromYaml(linksYaml(12, false))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configItemValues(array)).To(Equal(expected))
+
+		m, err := configuration.ItemFromYaml(linksYaml(12, true))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configItemValues(m)).To(Equal(expected))
+	})
+
+	It("should bind each key of the prefix to the iterator variable", func() {
+		cfg, err := configuration.NewSource("mem://")
+		Expect(err).NotTo(HaveOccurred())
+		links, err := configuration.ItemFromYaml(linksYaml(11, true))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.PutRecursive("o2/test/links", links)).To(Succeed())
+
+		root, err := loadTestWorkflow(`name: root
+roles:
+  - name: "link{{ .id }}"
+    for:
+      configPrefix: o2/test/links
+      var: id
+    task:
+      load: readout
+`, &loadContext{cfg: cfg})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleNames(root.GetRoles())).To(Equal([]string{
+			"link0", "link1", "link2", "link3", "link4", "link5",
+			"link6", "link7", "link8", "link9", "link10",
+		}))
+	})
+})
+
+var _ = Describe("nested iterators", func() {
+	It("should expand inner iterators for each outer value", func() {
+		root, err := loadTestWorkflow(`name: root
+roles:
+  - name: "flp{{ .i }}"
+    for:
+      begin: 1
+      end: 2
+      var: i
+    roles:
+      - name: "link{{ .j }}"
+        for:
+          values: [a, b]
+          var: j
+        task:
+          load: readout
+`, &loadContext{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleNames(root.GetRoles())).To(Equal([]string{"flp1", "flp2"}))
+		for _, flp := range root.GetRoles() {
+			Expect(roleNames(flp.GetRoles())).To(Equal([]string{"linka", "linkb"}))
+		}
+		paths := make([]string, 0)
+		for _, t := range collectTaskRoles(root) {
+			paths = append(paths, t.GetPath())
+		}
+		Expect(paths).To(Equal([]string{"root.flp1.linka", "root.flp1.linkb", "root.flp2.linka", "root.flp2.linkb"}))
+		Expect(root.GetRoles()[1].GetRoles()[0].GetVars()).To(And(HaveKeyWithValue("i", "2"), HaveKeyWithValue("j", "a")))
+	})
+
+	It("should aggregate the status and state of the generated roles", func() {
+		root, err := loadTestWorkflow(`name: root
+roles:
+  - name: "link{{ .j }}"
+    for:
+      values: [a, b]
+      var: j
+    task:
+      load: readout
+`, &loadContext{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(root.Roles).To(HaveLen(1))
+		iterator, ok := root.Roles[0].(*iteratorRole)
+		Expect(ok).To(BeTrue())
+		Expect(iterator.GetStatus()).To(Equal(root.GetRoles()[0].GetStatus()))
+		Expect(iterator.GetState()).To(Equal(root.GetRoles()[0].GetState()))
+	})
+})
+
+var _ = Describe("role names", func() {
+	It("should reject sibling roles with the same name", func() {
+		_, err := loadTestWorkflow(`name: root
+roles:
+  - name: readout
+    task:
+      load: readout
+  - name: readout
+    task:
+      load: readout
+`, &loadContext{})
+		Expect(err).To(MatchError(ContainSubstring("role name collision: more than one role at root.readout")))
+	})
+
+	It("should reject iterators which generate the same name twice", func() {
+		_, err := loadTestWorkflow(`name: root
+roles:
+  - name: "link{{ .j }}"
+    for:
+      values: [a, a]
+      var: j
+    task:
+      load: readout
+`, &loadContext{})
+		Expect(err).To(MatchError(ContainSubstring("generates role linka twice, for j=a and j=a")))
+	})
+
+	It("should accept alternative roles with the same name if only one is enabled", func() {
+		root, err := loadTestWorkflow(`name: root
+roles:
+  - name: readout
+    enabled: "true"
+    task:
+      load: readout
+  - name: readout
+    enabled: "false"
+    task:
+      load: readout-dummy
+`, &loadContext{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(roleNames(root.GetRoles())).To(Equal([]string{"readout"}))
+		Expect(root.GetRoles()[0].GetTaskClasses()[0]).To(ContainSubstring("readout@"))
+	})
+})
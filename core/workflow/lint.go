@@ -0,0 +1,138 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/AliceO2Group/Control/core/the"
+)
+
+// Lint looks for problems in a loaded workflow which would otherwise only
+// show up once its tasks are deployed or configured: fields whose templates
+// were not resolved (names, task classes, variables, constraints and
+// outbound channels), task roles whose class cannot be loaded and outbound
+// channels which do not resolve to a compatible inbound channel. Pruned roles are not
+// checked.
+func Lint(root Role) (problems []string) {
+	problems = make([]string, 0)
+	if root == nil {
+		return
+	}
+
+	var walk func(role Role)
+	walk = func(role Role) {
+		if isTemplated(role.GetName()) {
+			problems = append(problems, fmt.Sprintf("role %s: unresolved template in name", role.GetPath()))
+		}
+		for _, child := range role.GetRoles() {
+			walk(child)
+		}
+	}
+	walk(root)
+
+	// We load each task class once, the channels of roles whose class cannot
+	// be loaded are not checked
+	taskRoles := collectTaskRoles(root)
+	classes := make(map[string]*task.TaskClass)
+	classErrors := make(map[string]error)
+	for _, t := range taskRoles {
+		className := t.GetTaskClass()
+		if isTemplated(className) {
+			problems = append(problems, fmt.Sprintf("role %s: unresolved template in task class %s", t.GetPath(), className))
+			continue
+		}
+		class, loaded := classes[className]
+		classErr, failed := classErrors[className]
+		if !loaded && !failed {
+			class, classErr = lintLoadTaskClass(className)
+			if classErr != nil {
+				classErrors[className] = classErr
+			} else {
+				classes[className] = class
+			}
+		}
+		if classErr != nil {
+			problems = append(problems, fmt.Sprintf("role %s: unknown task class %s: %s", t.GetPath(), className, classErr.Error()))
+		}
+	}
+
+	problems = append(problems, lintTemplatedFields(taskRoles)...)
+	problems = append(problems, checkChannelTopology(taskRoles, classes)...)
+
+	sort.Strings(problems)
+	return
+}
+
+// lintTemplatedFields reports the variables, constraints and outbound channel
+// names of task roles which still contain a template. All of them may be
+// inherited from an aggregator: variables are reported for the role which
+// sets them, and identical problems are only reported once.
+// Outbound channel targets are checked by checkChannelTopology.
+func lintTemplatedFields(taskRoles []*taskRole) (problems []string) {
+	problems = make([]string, 0)
+	reported := make(map[string]bool)
+	report := func(problem string) {
+		if reported[problem] {
+			return
+		}
+		reported[problem] = true
+		problems = append(problems, problem)
+	}
+
+	for _, t := range taskRoles {
+		for k, v := range t.getRoleVars() {
+			if isTemplated(v.Value) {
+				report(fmt.Sprintf("%s: unresolved template in variable %s", v.Source, k))
+			}
+		}
+		for _, ct := range t.getConstraints() {
+			if isTemplated(ct.Attribute) || isTemplated(ct.Value) {
+				report(fmt.Sprintf("role %s: unresolved template in constraint %s=%s", t.GetPath(), ct.Attribute, ct.Value))
+			}
+		}
+		for _, outboundCh := range t.CollectOutboundChannels() {
+			if isTemplated(outboundCh.Name) {
+				report(fmt.Sprintf("role %s: unresolved template in outbound channel name %s", t.GetPath(), outboundCh.Name))
+			}
+		}
+	}
+	return
+}
+
+func lintLoadTaskClass(className string) (class *task.TaskClass, err error) {
+	err = the.RepoManager().EnsureReposPresent([]string{className})
+	if err != nil {
+		return
+	}
+	return task.LoadTaskClass(className)
+}
+
+func isTemplated(value string) bool {
+	return strings.Contains(value, "{{")
+}
@@ -0,0 +1,37 @@
+package workflow
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("workflow lint", func() {
+	It("should report unresolved templates in vars, constraints and channel names once", func() {
+		root, err := loadTestWorkflow(`name: root
+vars:
+  detector: "{{ .det }}"
+constraints:
+  - attribute: machine_id
+    value: "{{ .host }}"
+roles:
+  - name: a
+    connect:
+      - name: "data-{{ .link }}"
+        type: push
+        target: "{{ parent }}.b:data"
+    task:
+      load: readout
+  - name: b
+    task:
+      load: readout
+`, &loadContext{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(lintTemplatedFields(collectTaskRoles(root))).To(ConsistOf(
+			"role root: unresolved template in variable detector",
+			"role root.a: unresolved template in constraint machine_id={{ .host }}",
+			"role root.b: unresolved template in constraint machine_id={{ .host }}",
+			"role root.a: unresolved template in outbound channel name data-{{ .link }}",
+		))
+	})
+})
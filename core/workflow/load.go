@@ -25,21 +25,179 @@
 package workflow
 
 import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
 	"github.com/AliceO2Group/Control/configuration"
 	"github.com/AliceO2Group/Control/core/repos"
+	"github.com/AliceO2Group/Control/core/schema"
 	"github.com/AliceO2Group/Control/core/task"
 	"github.com/AliceO2Group/Control/core/the"
+	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 	"io/ioutil"
 )
 
+// loadContext gives roles access to the rest of the core while their
+// templates are processed, e.g. for iterators which loop over configuration
+// keys or Mesos agents.
+type loadContext struct {
+	cfg        configuration.ROSource
+	agentCache *task.AgentCache
+	globalVars map[string]string
+	userVars   map[string]string
+}
+
+// templateValues layers the variables visible to the templates of a role while
+// the workflow is loaded: the global variables, the role's variables and the
+// user variables, in increasing order of precedence. This is the same order
+// as for GetEffectiveVars, minus the task class defaults.
+func (loadCtx *loadContext) templateValues(roleVars task.VarMap) (values templateMap) {
+	values = make(templateMap)
+	if loadCtx != nil {
+		for k, v := range loadCtx.globalVars {
+			values[k] = v
+		}
+	}
+	for k, v := range roleVars {
+		values[k] = v
+	}
+	if loadCtx != nil {
+		for k, v := range loadCtx.userVars {
+			values[k] = v
+		}
+	}
+	return
+}
+
 // FIXME: workflowPath should be of type configuration.Path, not string
-func Load(cfg configuration.ROSource, workflowPath string, parent Updatable, taskManager *task.Manager) (workflow Role, err error) {
+// Load also returns the revision of the workflow template which was loaded, in the
+// form repo/workflows/name@hash.
+// userVars are the variables passed when creating the environment, they take
+// precedence over the ones set in the workflow and in the configuration.
+func Load(cfg configuration.ROSource, workflowPath string, parent Updatable, taskManager *task.Manager, userVars map[string]string) (workflow Role, workflowRevision string, err error) {
+	workflow, workflowRevision, err = loadTree(cfg, workflowPath, parent, taskManager, userVars)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Update class list
+	taskClassesRequired := workflow.GetTaskClasses()
+	err = the.RepoManager().EnsureReposPresent(taskClassesRequired)
+	if err != nil {
+		return
+	}
+	err = taskManager.RefreshClasses(taskClassesRequired)
+	if err != nil {
+		return
+	}
+
+	// Outbound channels which cannot be connected would otherwise only be
+	// noticed when configuring the environment
+	classes := make(map[string]*task.TaskClass)
+	for _, className := range taskClassesRequired {
+		if class := taskManager.GetTaskClass(className); class != nil {
+			classes[className] = class
+		}
+	}
+	problems := checkChannelTopology(collectTaskRoles(workflow), classes)
+	if len(problems) != 0 {
+		err = errors.New(fmt.Sprintf("%d channel topology problem(s) in workflow %s:\n%s",
+			len(problems), workflowPath, strings.Join(problems, "\n")))
+		return nil, "", err
+	}
+	return
+}
+
+// Render loads a workflow template the same way Load does, but its task
+// classes are only checked, and not registered with the task manager.
+// Along with the expanded workflow, it returns the problems found by Lint.
+// An error is only returned if the workflow could not be loaded at all.
+func Render(cfg configuration.ROSource, workflowPath string, taskManager *task.Manager, userVars map[string]string) (workflow Role, workflowRevision string, problems []string, err error) {
+	// Loading checks out the revisions the workflow and its includes are
+	// pinned to, rendering must leave the repos as they were
+	defer snapshotRepoRevisions().restore()
+
+	workflow, workflowRevision, err = loadTree(cfg, workflowPath, nil, taskManager, userVars)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	problems = Lint(workflow)
+	return
+}
+
+// repoRevisions holds the revision each known repo has checked out.
+type repoRevisions map[*repos.Repo]string
+
+func snapshotRepoRevisions() (revisions repoRevisions) {
+	revisions = make(repoRevisions)
+	for _, repo := range the.RepoManager().GetRepos() {
+		revisions[repo] = repo.Revision
+	}
+	return
+}
+
+// restore checks out again the revisions of the snapshot, for the repos which
+// have moved since.
+func (revisions repoRevisions) restore() {
 	repoManager := the.RepoManager()
+	for repo, revision := range revisions {
+		if repo.Revision == revision {
+			continue
+		}
+		err := repoManager.CheckoutRevision(repo, revision)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+					"repo": repo.GetIdentifier(),
+					"revision": revision,
+				}).
+				WithError(err).
+				Error("cannot restore repo revision")
+		}
+	}
+}
 
-	var resolvedWorkflowPath string
+// loadTree loads a workflow template and processes its templates, which
+// expands iterators and includes and prunes disabled roles.
+func loadTree(cfg configuration.ROSource, workflowPath string, parent Updatable, taskManager *task.Manager, userVars map[string]string) (workflow Role, workflowRevision string, err error) {
+	var root *aggregatorRole
 	var workflowRepo *repos.Repo
-	resolvedWorkflowPath, workflowRepo, err = repoManager.GetWorkflow(workflowPath) //Will fail if repo unknown
+	root, workflowRepo, workflowRevision, err = loadWorkflowFile(workflowPath, parent)
+	if err != nil {
+		return nil, "", err
+	}
+
+	loadCtx := &loadContext{
+		cfg: cfg,
+		globalVars: the.ConfSvc().GetVars(),
+		userVars: userVars,
+	}
+	if taskManager != nil {
+		loadCtx.agentCache = &taskManager.AgentCache
+	}
+
+	// The global variables are read once, the effective variables of the
+	// roles are resolved against this same snapshot
+	root.globalVars = loadCtx.globalVars
+
+	workflow = root
+	err = workflow.ProcessTemplates(workflowRepo, loadCtx)
+	if err != nil {
+		return
+	}
+	log.WithField("path", workflowPath).Debug("workflow loaded")
+	//pp.Println(workflow)
+	return
+}
+
+// loadWorkflowFile reads, validates and unmarshals a workflow template, without
+// processing its templates. It is used both for top level workflows and for
+// include roles.
+func loadWorkflowFile(workflowPath string, parent Updatable) (root *aggregatorRole, workflowRepo *repos.Repo, workflowRevision string, err error) {
+	var resolvedWorkflowPath string
+	resolvedWorkflowPath, workflowRepo, err = the.RepoManager().GetWorkflow(workflowPath) //Will fail if repo unknown
 	if err != nil {
 		return
 	}
@@ -50,27 +208,24 @@ func Load(cfg configuration.ROSource, workflowPath string, parent Updatable, tas
 		return
 	}
 
-	root := new(aggregatorRole)
+	err = schema.ValidateWorkflow(resolvedWorkflowPath, yamlDoc)
+	if err != nil {
+		return
+	}
+
+	root = new(aggregatorRole)
 	root.parent = parent
 	err = yaml.Unmarshal(yamlDoc, root)
 	if err != nil {
-		return nil, err
+		return nil, nil, "", err
 	}
 	if parent != nil {
 		root.parent = parent
 	}
 
-	workflow = root
-	workflow.ProcessTemplates(workflowRepo)
-	log.WithField("path", workflowPath).Debug("workflow loaded")
-	//pp.Println(workflow)
-
-	// Update class list
-	taskClassesRequired := workflow.GetTaskClasses()
-	err = repoManager.EnsureReposPresent(taskClassesRequired)
-	if err != nil {
-		return
-	}
-	err = taskManager.RefreshClasses(taskClassesRequired)
+	workflowRevision = fmt.Sprintf("%sworkflows/%s@%s",
+		workflowRepo.GetIdentifier(),
+		strings.TrimSuffix(filepath.Base(resolvedWorkflowPath), ".yaml"),
+		workflowRepo.Hash)
 	return
 }
@@ -37,13 +37,18 @@ type GetEnvIdFunc func() uuid.Array
 type ParentAdapter struct {
 	mu sync.Mutex
 	getEnvIdFunc GetEnvIdFunc
+	userVars map[string]string
 	stateSubscriptions map[string]chan task.State
 	statusSubscriptions map[string]chan task.Status
 }
 
-func NewParentAdapter(getEnvId GetEnvIdFunc) *ParentAdapter {
+// NewParentAdapter builds the parent of the root role of an environment's
+// workflow. userVars are the variables passed when creating the environment,
+// which take precedence over all the others.
+func NewParentAdapter(getEnvId GetEnvIdFunc, userVars map[string]string) *ParentAdapter {
 	return &ParentAdapter{
 		getEnvIdFunc: getEnvId,
+		userVars: userVars,
 		stateSubscriptions: make(map[string]chan task.State),
 		statusSubscriptions: make(map[string]chan task.Status, 0),
 	}
@@ -99,6 +104,10 @@ func (i *ParentAdapter) GetParent() Updatable {
 	return nil
 }
 
+func (p *ParentAdapter) GetUserVars() map[string]string {
+	return p.userVars
+}
+
 
 func (p *ParentAdapter) GetEnvironmentId() uuid.Array {
 	return p.getEnvIdFunc()
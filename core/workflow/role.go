@@ -42,16 +42,22 @@ type Role interface {
 	GetParent() Updatable
 	GetParentRole() Role
 	GetRoles() []Role
+	GetPrunedRoles() []Role
+	GetPruneReason() string
 	GetPath() string
 	GetName() string
 	GetStatus() task.Status
 	GetState() task.State
 	GetTasks() task.Tasks
 	GetTaskClasses() []string
+	GetVars() task.VarMap
+	GetEffectiveVars() task.ScopedVarMap
 	GenerateTaskDescriptors() task.Descriptors
 	getConstraints() constraint.Constraints
+	getRoleVars() task.ScopedVarMap
+	getGlobalVars() map[string]string
 	setParent(role Updatable)
-	ProcessTemplates(workflowRepo *repos.Repo) error
+	ProcessTemplates(workflowRepo *repos.Repo, loadCtx *loadContext) error
 	GlobFilter(g glob.Glob) []Role
 }
 
@@ -27,12 +27,14 @@ package workflow
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/AliceO2Group/Control/core/task/channel"
-	"github.com/jinzhu/copier"
 	"github.com/sirupsen/logrus"
 
 	"github.com/AliceO2Group/Control/core/task"
@@ -54,8 +56,11 @@ type roleBase struct {
 	Vars        task.VarMap             `yaml:"vars,omitempty"`
 	Connect     []channel.Outbound      `yaml:"connect,omitempty"`
 	Constraints constraint.Constraints  `yaml:"constraints,omitempty"`
+	Enabled     string                  `yaml:"enabled,omitempty"`
 	status      SafeStatus
 	state       SafeState
+	pruneReason string
+	globalVars  map[string]string // only set on the root role, see getGlobalVars
 }
 
 func (r *roleBase) CollectOutboundChannels() (channels []channel.Outbound) {
@@ -64,11 +69,20 @@ func (r *roleBase) CollectOutboundChannels() (channels []channel.Outbound) {
 	} else {
 		channels = r.parent.CollectOutboundChannels()
 	}
+	// An outbound channel with the same name as one of an ancestor role
+	// overrides it, otherwise it is added to the inherited ones
 	for _, v := range r.Connect {
-		channels = append(channels, v)
-		// FIXME: this does not take into account child roles with outbound channels with the same name
-		// as an outbound channel in the parent.
-		// The correct behaviour would be OVERRIDE, currently the behavior is UNDEFINED.
+		overridden := false
+		for i := range channels {
+			if channels[i].Name == v.Name {
+				channels[i] = v
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			channels = append(channels, v)
+		}
 	}
 	return
 }
@@ -143,19 +157,30 @@ func (r *roleBase) resolveOutboundChannelTargets() {
 }
 
 func (r *roleBase) copy() copyable {
-	rCopy := roleBase{
-		Name: r.Name,
-		parent: r.parent,
-		Vars: make(task.VarMap),
-		Connect: make([]channel.Outbound, len(r.Connect)),
-		Constraints: make(constraint.Constraints, len(r.Constraints)),
-		status: r.status,
-		state: r.state,
-	}
+	rCopy := new(roleBase)
+	r.copyTo(rCopy)
+	return rCopy
+}
 
-	err := copier.Copy(&rCopy.Vars, &r.Vars)
-	if err != nil {
-		log.WithField("role", r.GetPath()).WithError(err).Error("role copy error")
+// copyTo fills rCopy, a newly allocated role, with a copy of r. Roles which
+// embed roleBase can use it to build their copy in place, instead of copying
+// a roleBase value along with the locks of its status and state.
+func (r *roleBase) copyTo(rCopy *roleBase) {
+	rCopy.Name = r.Name
+	rCopy.parent = r.parent
+	rCopy.Vars = make(task.VarMap)
+	rCopy.Connect = make([]channel.Outbound, len(r.Connect))
+	rCopy.Constraints = make(constraint.Constraints, len(r.Constraints))
+	rCopy.Enabled = r.Enabled
+	rCopy.status.status = r.status.get()
+	rCopy.state.state = r.state.get()
+	rCopy.pruneReason = r.pruneReason
+	rCopy.globalVars = r.globalVars
+
+	// Roles generated by an iterator each get their own value of the loop
+	// variable, so they must not share the Vars map
+	for k, v := range r.Vars {
+		rCopy.Vars[k] = v
 	}
 
 	copied := copy(rCopy.Connect, r.Connect)
@@ -171,8 +196,6 @@ func (r *roleBase) copy() copyable {
 			WithError(fmt.Errorf("slice copy copied %d items, %d expected", copied, len(r.Constraints))).
 			Error("role copy error")
 	}
-
-	return &rCopy
 }
 
 func (r *roleBase) GetParent() Updatable {
@@ -261,4 +284,76 @@ func (r *roleBase) getConstraints() (cts constraint.Constraints) {
 	}
 
 	return
-}
\ No newline at end of file
+}
+
+// GetVars returns the workflow variables visible from this role, i.e. the ones
+// set on the role itself, which override the ones of its ancestors. See
+// GetEffectiveVars for all the variables of the role, with their provenance.
+func (r *roleBase) GetVars() (vars task.VarMap) {
+	vars = make(task.VarMap)
+	if r == nil {
+		return
+	}
+	if parentRole := r.GetParentRole(); parentRole != nil {
+		for k, v := range parentRole.GetVars() {
+			vars[k] = v
+		}
+	}
+	for k, v := range r.Vars {
+		vars[k] = v
+	}
+	return
+}
+
+func (r *roleBase) setVar(key string, value string) {
+	if r.Vars == nil {
+		r.Vars = make(task.VarMap)
+	}
+	r.Vars[key] = value
+}
+
+func (r *roleBase) GetPruneReason() string {
+	if r == nil {
+		return ""
+	}
+	return r.pruneReason
+}
+
+// isEnabled evaluates the enabled expression of the role, a Go template
+// which must yield true or false, against the variables of the role, see
+// loadContext.templateValues.
+// A role without an enabled expression is always enabled.
+func (r *roleBase) isEnabled(loadCtx *loadContext) (enabled bool, err error) {
+	expr := strings.TrimSpace(r.Enabled)
+	if len(expr) == 0 {
+		return true, nil
+	}
+
+	values := loadCtx.templateValues(r.GetVars())
+
+	var parsed *template.Template
+	parsed, err = template.New(r.GetPath()).Option("missingkey=error").Parse(expr)
+	if err != nil {
+		return
+	}
+	buf := new(bytes.Buffer)
+	err = parsed.Execute(buf, values)
+	if err != nil {
+		return
+	}
+
+	result := strings.TrimSpace(buf.String())
+	enabled, err = strconv.ParseBool(result)
+	if err != nil {
+		err = errors.New(fmt.Sprintf("enabled expression %s evaluated to %s, expecting true or false", expr, result))
+		return
+	}
+	if !enabled {
+		if expr == result {
+			r.pruneReason = fmt.Sprintf("enabled: %s", expr)
+		} else {
+			r.pruneReason = fmt.Sprintf("enabled: %s evaluated to %s", expr, result)
+		}
+	}
+	return
+}
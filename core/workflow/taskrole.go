@@ -69,7 +69,7 @@ func (t *taskRole) GlobFilter(g glob.Glob) (rs []Role) {
 	return
 }
 
-func (t *taskRole) ProcessTemplates(workflowRepo *repos.Repo) (err error) {
+func (t *taskRole) ProcessTemplates(workflowRepo *repos.Repo, loadCtx *loadContext) (err error) {
 	if t == nil {
 		return errors.New("role tree error when processing templates")
 	}
@@ -171,6 +171,10 @@ func (*taskRole) GetRoles() []Role {
 	return nil
 }
 
+func (*taskRole) GetPrunedRoles() []Role {
+	return nil
+}
+
 //FIXME: figure out if nested doTransition calls are even desirable
 // Intead of this stuff, we could have a similar method which does not perform a transition,
 // but just builds the mesoscommand_transition and sends it.
@@ -197,7 +201,3 @@ func (*taskRole) GetRoles() []Role {
 func (t *taskRole) setParent(role Updatable) {
 	t.parent = role
 }
-
-func (t *taskRole) GetVars() task.VarMap {
-	panic("niy")
-}
\ No newline at end of file
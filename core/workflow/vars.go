@@ -0,0 +1,137 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package workflow
+
+import (
+	"github.com/AliceO2Group/Control/core/task"
+)
+
+// Sources of the effective variables of a role, see GetEffectiveVars.
+const (
+	VAR_SOURCE_TASK_CLASS    = "task class"
+	VAR_SOURCE_CONFIGURATION = "configuration"
+	VAR_SOURCE_ROLE          = "role"
+	VAR_SOURCE_ENVIRONMENT   = "environment"
+)
+
+// userVarsProvider is implemented by the parent of a workflow's root role
+// when the workflow belongs to an environment, see ParentAdapter.
+type userVarsProvider interface {
+	GetUserVars() map[string]string
+}
+
+// GetEffectiveVars resolves the variables of the role along with the layer
+// which supplied each value. In increasing order of precedence, they are:
+//   1. the defaults of the task class, for a deployed task role,
+//   2. the global variables in the configuration,
+//   3. the vars of the ancestors of the role, the closest one winning,
+//   4. the vars of the role itself,
+//   5. the variables passed when creating the environment.
+// The global variables are the ones read when the workflow was loaded, so
+// enabled expressions and iterators saw the same variables, except for the
+// task class defaults since task classes are only loaded afterwards.
+func (r *roleBase) GetEffectiveVars() (vars task.ScopedVarMap) {
+	return r.resolveVars(nil)
+}
+
+func (r *roleBase) resolveVars(classDefaults task.ScopedVarMap) (vars task.ScopedVarMap) {
+	vars = make(task.ScopedVarMap)
+	if r == nil {
+		return
+	}
+	for k, v := range classDefaults {
+		vars[k] = v
+	}
+	for k, v := range r.getGlobalVars() {
+		vars[k] = task.ScopedVar{Value: v, Source: VAR_SOURCE_CONFIGURATION}
+	}
+	for k, v := range r.getRoleVars() {
+		vars[k] = v
+	}
+	for k, v := range r.getUserVars() {
+		vars[k] = task.ScopedVar{Value: v, Source: VAR_SOURCE_ENVIRONMENT}
+	}
+	return
+}
+
+// getRoleVars returns the vars set on the role and on its ancestors, each
+// with the path of the role which set it.
+func (r *roleBase) getRoleVars() (vars task.ScopedVarMap) {
+	vars = make(task.ScopedVarMap)
+	if r == nil {
+		return
+	}
+	if parentRole := r.GetParentRole(); parentRole != nil {
+		for k, v := range parentRole.getRoleVars() {
+			vars[k] = v
+		}
+	}
+	for k, v := range r.Vars {
+		vars[k] = task.ScopedVar{Value: v, Source: VAR_SOURCE_ROLE + " " + r.GetPath()}
+	}
+	return
+}
+
+// getGlobalVars returns the global variables of the configuration as they
+// were when the workflow was loaded, which the root role keeps.
+func (r *roleBase) getGlobalVars() map[string]string {
+	if r == nil {
+		return nil
+	}
+	if parentRole := r.GetParentRole(); parentRole != nil {
+		return parentRole.getGlobalVars()
+	}
+	return r.globalVars
+}
+
+// getUserVars walks up to the parent of the root role, which knows the
+// variables of the environment if there is one.
+func (r *roleBase) getUserVars() map[string]string {
+	var parent = r.GetParent()
+	for parent != nil {
+		if provider, ok := parent.(userVarsProvider); ok {
+			return provider.GetUserVars()
+		}
+		parent = parent.GetParent()
+	}
+	return nil
+}
+
+// GetEffectiveVars resolves the variables of the task role, starting from the
+// defaults of the task class once a task is deployed for the role.
+func (t *taskRole) GetEffectiveVars() (vars task.ScopedVarMap) {
+	if t == nil {
+		return make(task.ScopedVarMap)
+	}
+	classDefaults := make(task.ScopedVarMap)
+	if t.Task != nil {
+		if class := t.Task.GetTaskClass(); class != nil {
+			for k, v := range class.Defaults {
+				classDefaults[k] = task.ScopedVar{Value: v, Source: VAR_SOURCE_TASK_CLASS + " " + t.Task.GetClassName()}
+			}
+		}
+	}
+	return t.resolveVars(classDefaults)
+}
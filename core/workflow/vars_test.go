@@ -0,0 +1,49 @@
+package workflow
+
+import (
+	"github.com/AliceO2Group/Control/core/task"
+	"github.com/pborman/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("role variables", func() {
+	var (
+		root *aggregatorRole
+		err  error
+	)
+
+	BeforeEach(func() {
+		loadCtx := &loadContext{
+			globalVars: map[string]string{"a": "global", "b": "global", "c": "global", "d": "global"},
+			userVars:   map[string]string{"d": "user"},
+		}
+		root, err = loadTestWorkflow(`name: root
+vars:
+  b: root
+  c: root
+roles:
+  - name: child
+    vars:
+      c: child
+    enabled: '{{ and (eq .a "global") (eq .b "root") (eq .c "child") (eq .d "user") }}'
+    task:
+      load: readout
+`, loadCtx)
+		Expect(err).NotTo(HaveOccurred())
+		root.setParent(NewParentAdapter(func() uuid.Array { return uuid.Array{} }, loadCtx.userVars))
+	})
+
+	It("should see the same variables in enabled expressions as after loading", func() {
+		Expect(roleNames(root.GetRoles())).To(Equal([]string{"child"}))
+	})
+
+	It("should apply the precedence of global, role and user variables", func() {
+		Expect(root.GetRoles()[0].GetEffectiveVars()).To(Equal(task.ScopedVarMap{
+			"a": {Value: "global", Source: VAR_SOURCE_CONFIGURATION},
+			"b": {Value: "root", Source: VAR_SOURCE_ROLE + " root"},
+			"c": {Value: "child", Source: VAR_SOURCE_ROLE + " root.child"},
+			"d": {Value: "user", Source: VAR_SOURCE_ENVIRONMENT},
+		}))
+	})
+})
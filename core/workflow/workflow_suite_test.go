@@ -0,0 +1,44 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/AliceO2Group/Control/core/repos"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+func TestWorkflow(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Workflow Suite")
+}
+
+var _ = BeforeSuite(func() {
+	// Effective vars read the global variables from the configuration service
+	viper.Set("globalConfigurationUri", "mem://")
+})
+
+// loadTestWorkflow unmarshals a workflow and processes its templates like
+// loadTree does, without going through the repository manager.
+func loadTestWorkflow(doc string, loadCtx *loadContext) (root *aggregatorRole, err error) {
+	root = new(aggregatorRole)
+	err = yaml.Unmarshal([]byte(doc), root)
+	if err != nil {
+		return
+	}
+	if loadCtx != nil {
+		root.globalVars = loadCtx.globalVars
+	}
+	err = root.ProcessTemplates(&repos.Repo{}, loadCtx)
+	return
+}
+
+func roleNames(roles []Role) (names []string) {
+	names = make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.GetName())
+	}
+	return
+}
@@ -0,0 +1,283 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executor
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/controlmode"
+	"github.com/AliceO2Group/Control/common/event"
+	"github.com/AliceO2Group/Control/executor/executorcmd"
+	"github.com/AliceO2Group/Control/executor/executorcmd/transitioner"
+	"github.com/AliceO2Group/Control/executor/protos"
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/sirupsen/logrus"
+)
+
+const basicStopTimeout = 10 * time.Second
+
+// basicTask keeps track of a task in BASIC control mode. Unlike tasks controlled through OCC,
+// the Mesos task outlives its process: the latter is only started at START and signalled at
+// STOP, while the Mesos task ends when it is killed.
+type basicTask struct {
+	mu          sync.Mutex
+	task        mesos.TaskInfo
+	commandInfo common.TaskCommandInfo
+	taskCmd     *exec.Cmd
+	exited      chan struct{}
+	stopping    bool
+
+	done     chan struct{}
+	doneOnce sync.Once
+
+	// called if the process exits without having been asked to
+	onProcessExit func(err error)
+}
+
+// runBasicTask sets up a task in BASIC or HOOK control mode. There is no process to start yet
+// and no OCC endpoint to wait for, so the task is immediately RUNNING for Mesos, and STANDBY
+// for us. In HOOK mode, the transitioner runs a command on each transition and there is no
+// long-running process at all.
+func runBasicTask(state *internalState, task mesos.TaskInfo, commandInfo common.TaskCommandInfo, status mesos.TaskStatus) {
+	bt := &basicTask{
+		task:        task,
+		commandInfo: commandInfo,
+		done:        make(chan struct{}),
+	}
+	var rpcClient *executorcmd.RpcClient
+	if commandInfo.ControlMode == controlmode.HOOK {
+		rpcClient = executorcmd.NewHookClient(commandInfo.Hooks, commandInfo.Env)
+	} else {
+		rpcClient = executorcmd.NewBasicClient(bt.doTransition)
+	}
+
+	deo := event.DeviceEventOrigin{
+		AgentId: task.AgentID,
+		ExecutorId: task.GetExecutor().ExecutorID,
+		TaskId: task.TaskID,
+	}
+	bt.onProcessExit = func(err error) {
+		// A basic task that finishes on its own is no longer RUNNING, but it's only an error
+		// if it exited with non-zero status
+		reachedState, trigger := "CONFIGURED", pb.StateChangeTrigger_DEVICE_INTENTIONAL
+		if err != nil {
+			reachedState, trigger = "ERROR", pb.StateChangeTrigger_DEVICE_ERROR
+		}
+		rpcClient.SetLocalState(reachedState)
+		sendDeviceStateChanged(state, deo, reachedState, trigger)
+	}
+
+	state.mu.Lock()
+	state.rpcClients[task.TaskID] = rpcClient
+	state.basicTasks[task.TaskID] = bt
+
+	log.WithField("task", task.Name).Debug("notifying of basic task running state")
+	status.State = mesos.TASK_RUNNING.Enum()
+	err := update(state, status)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+				"id":  task.TaskID.Value,
+				"task": task.Name,
+				"error": err.Error(),
+			}).
+			Error("failed to send TASK_RUNNING")
+		status.State = mesos.TASK_FAILED.Enum()
+		status.Message = protoString(err.Error())
+
+		state.failedTasks[task.TaskID] = status
+		delete(state.rpcClients, task.TaskID)
+		delete(state.basicTasks, task.TaskID)
+		state.mu.Unlock()
+		return
+	}
+	state.mu.Unlock()
+
+	go func() {
+		<-bt.done
+
+		state.mu.Lock()
+		defer state.mu.Unlock()
+		delete(state.rpcClients, task.TaskID)
+		delete(state.basicTasks, task.TaskID)
+
+		if _, ok := state.killedTasks[task.TaskID]; ok {
+			status = state.killedTasks[task.TaskID]
+			delete(state.killedTasks, task.TaskID)
+		} else {
+			status = newStatus(state, task.TaskID)
+			status.State = mesos.TASK_FINISHED.Enum()
+		}
+		log.WithField("task", task.Name).
+			WithField("status", status.State.String()).
+			Debug("sending final status update")
+
+		err := update(state, status)
+		if err != nil {
+			log.WithFields(logrus.Fields{
+					"id":    task.TaskID.Value,
+					"name":  task.Name,
+					"error": err.Error(),
+				}).
+				Error("failed to send final status update")
+			status.State = mesos.TASK_FAILED.Enum()
+			status.Message = protoString(err.Error())
+			state.failedTasks[task.TaskID] = status
+		}
+	}()
+	log.WithField("task", task.Name).Debug("basic task ready for control input")
+}
+
+// doTransition is called by the basic transitioner for the events which require acting
+// on the process.
+func (bt *basicTask) doTransition(ei transitioner.EventInfo) (newState string, err error) {
+	switch ei.Evt {
+	case "START":
+		err = bt.start()
+	case "STOP", "EXIT":
+		err = bt.stop()
+	default:
+		err = fmt.Errorf("transition %s not supported in basic control mode", ei.Evt)
+	}
+
+	if err != nil {
+		newState = ei.Src
+		return
+	}
+	newState = ei.Dst
+	return
+}
+
+func (bt *basicTask) start() (err error) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+
+	if bt.taskCmd != nil {
+		return fmt.Errorf("process for task %s already running", bt.task.Name)
+	}
+
+	taskCmd := prepareTaskCmd(bt.commandInfo)
+	stdoutIn, _ := taskCmd.StdoutPipe()
+	stderrIn, _ := taskCmd.StderrPipe()
+
+	log.WithField("task", bt.task.Name).Debug("starting basic task process")
+	err = taskCmd.Start()
+	if err != nil {
+		log.WithFields(logrus.Fields{
+				"id":      bt.task.TaskID.Value,
+				"task":    bt.task.Name,
+				"error":   err,
+				"command": *bt.commandInfo.Value,
+			}).
+			Error("failed to run basic task process")
+		return
+	}
+
+	go func() {
+		_, _ = io.Copy(log.WithPrefix("task-stdout").WithField("task", bt.task.Name).Writer(), stdoutIn)
+	}()
+	go func() {
+		_, _ = io.Copy(log.WithPrefix("task-stderr").WithField("task", bt.task.Name).Writer(), stderrIn)
+	}()
+
+	exited := make(chan struct{})
+	bt.taskCmd = taskCmd
+	bt.exited = exited
+	bt.stopping = false
+
+	go func() {
+		waitErr := taskCmd.Wait()
+
+		bt.mu.Lock()
+		stopping := bt.stopping
+		bt.taskCmd = nil
+		close(exited)
+		bt.mu.Unlock()
+
+		if stopping {
+			log.WithField("task", bt.task.Name).Debug("basic task process stopped")
+			return
+		}
+		log.WithField("task", bt.task.Name).
+			WithError(waitErr).
+			Warning("basic task process exited on its own")
+		if bt.onProcessExit != nil {
+			bt.onProcessExit(waitErr)
+		}
+	}()
+	return
+}
+
+// stop sends SIGTERM to the process group and waits for it to exit, resorting to SIGKILL
+// after basicStopTimeout. It's a no-op if the process isn't running.
+func (bt *basicTask) stop() (err error) {
+	bt.mu.Lock()
+	taskCmd := bt.taskCmd
+	exited := bt.exited
+	if taskCmd == nil {
+		bt.mu.Unlock()
+		return
+	}
+	bt.stopping = true
+	bt.mu.Unlock()
+
+	err = syscall.Kill(-taskCmd.Process.Pid, syscall.SIGTERM)
+	if err != nil {
+		log.WithError(err).WithField("task", bt.task.Name).Warning("could not signal basic task process")
+	}
+
+	select {
+	case <-exited:
+		err = nil
+	case <-time.After(basicStopTimeout):
+		log.WithField("task", bt.task.Name).
+			WithField("timeout", basicStopTimeout.String()).
+			Warning("basic task process did not exit in time, killing")
+		err = syscall.Kill(-taskCmd.Process.Pid, syscall.SIGKILL)
+		if err != nil {
+			return
+		}
+		<-exited
+	}
+	return
+}
+
+// end kills any leftover process and lets runBasicTask send the final status update.
+func (bt *basicTask) end() {
+	bt.doneOnce.Do(func() {
+		bt.mu.Lock()
+		if bt.taskCmd != nil {
+			bt.stopping = true
+			_ = syscall.Kill(-bt.taskCmd.Process.Pid, syscall.SIGKILL)
+		}
+		bt.mu.Unlock()
+		close(bt.done)
+	})
+}
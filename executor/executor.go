@@ -45,6 +45,7 @@ import (
 	"time"
 
 	"github.com/AliceO2Group/Control/common"
+	"github.com/AliceO2Group/Control/common/controlmode"
 	"github.com/AliceO2Group/Control/common/event"
 	"github.com/AliceO2Group/Control/common/logger"
 	"github.com/AliceO2Group/Control/core/controlcommands"
@@ -119,6 +120,9 @@ func Run(cfg config.Config) {
 			failedTasks:    make(map[mesos.TaskID]mesos.TaskStatus),
 			killedTasks:    make(map[mesos.TaskID]mesos.TaskStatus),
 			rpcClients:     make(map[mesos.TaskID]*executorcmd.RpcClient),
+			restartingTasks: make(map[mesos.TaskID]uint),
+			basicTasks:     make(map[mesos.TaskID]*basicTask),
+			shmSessions:    make(map[string]map[mesos.TaskID]struct{}),
 		}
 		subscriber = calls.SenderWith(
 			// Here too, callOptions for all outgoing subscriber calls
@@ -356,7 +360,8 @@ func handleMessage(state *internalState, data []byte) (err error) {
 		state.mu.RUnlock()
 
 		if cmd.Name == "CONFIGURE" {
-			log.WithFields(logrus.Fields{"map": cmd.Arguments, "taskId": taskId}).Debug("CONFIGURE pushing FairMQ properties")
+			// Property values may be resolved secrets, we only log how many there are
+			log.WithFields(logrus.Fields{"properties": len(cmd.Arguments), "taskId": taskId}).Debug("CONFIGURE pushing FairMQ properties")
 		}
 
 		newState, transitionError := cmd.Commit()
@@ -396,8 +401,12 @@ func launch(state *internalState, task mesos.TaskInfo) {
 	state.mu.Lock()
 
 	state.unackedTasks[task.TaskID] = task
-	jsonTask, _ := json.MarshalIndent(task, "", "\t")
-	log.WithField("payload", fmt.Sprintf("%s", jsonTask[:])).Debug("received task to launch")
+	// The task payload may carry resolved secrets, so it must never be logged
+	log.WithFields(logrus.Fields{
+			"id":   task.TaskID.Value,
+			"task": task.Name,
+		}).
+		Debug("received task to launch")
 
 	status := newStatus(state, task.TaskID)
 
@@ -405,13 +414,13 @@ func launch(state *internalState, task mesos.TaskInfo) {
 
 	tciData := task.GetData()
 
-	log.WithField("json", string(tciData[:])).Debug("received TaskCommandInfo")
+	log.WithField("size", len(tciData)).Debug("received TaskCommandInfo")
 	if err := json.Unmarshal(tciData, &commandInfo); tciData != nil && err == nil {
 		log.WithFields(logrus.Fields{
 				"shell": *commandInfo.Shell,
 				"value": *commandInfo.Value,
-				"args":  commandInfo.Arguments,
-				"task": task.Name,
+				"args":  len(commandInfo.Arguments),
+				"task":  task.Name,
 			}).
 			Info("launching task")
 	} else {
@@ -426,9 +435,18 @@ func launch(state *internalState, task mesos.TaskInfo) {
 		state.mu.Unlock()
 		return
 	}
+	addShmSessionTask(state, commandInfo.ShmSession, task.TaskID)
 	state.mu.Unlock()
 
-	var taskCmd *exec.Cmd
+	if commandInfo.ControlMode == controlmode.BASIC || commandInfo.ControlMode == controlmode.HOOK {
+		runBasicTask(state, task, commandInfo, status)
+		return
+	}
+	runTask(state, task, commandInfo, status, 0)
+}
+
+// prepareTaskCmd builds the exec.Cmd for the process of a task, without starting it.
+func prepareTaskCmd(commandInfo common.TaskCommandInfo) (taskCmd *exec.Cmd) {
 	if *commandInfo.Shell {
 		rawCommand := strings.Join(append([]string{*commandInfo.Value}, commandInfo.Arguments...), " ")
 		taskCmd = exec.Command("/bin/sh", []string{"-c", rawCommand}...)
@@ -440,12 +458,23 @@ func launch(state *internalState, task mesos.TaskInfo) {
 	// We must setpgid(2) in order to be able to kill the whole process group which consists of
 	// the containing shell and all of its children
 	taskCmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	return
+}
+
+// runTask starts the process of a task which was already accepted by launch, waits for it to
+// become ready for control input and then supervises it until it exits.
+// If the process exits with an error and the restart policy of the task allows it, runTask is
+// invoked again after a backoff delay, with an incremented restartCount.
+func runTask(state *internalState, task mesos.TaskInfo, commandInfo common.TaskCommandInfo, status mesos.TaskStatus, restartCount uint) {
+	taskCmd := prepareTaskCmd(commandInfo)
 
 	var errStdout, errStderr error
 	stdoutIn, _ := taskCmd.StdoutPipe()
 	stderrIn, _ := taskCmd.StderrPipe()
 
-	log.WithField("payload", string(task.GetData()[:])).WithField("task", task.Name).Debug("starting task")
+	log.WithField("task", task.Name).
+		WithField("restartCount", restartCount).
+		Debug("starting task")
 	err := taskCmd.Start()
 	if err != nil {
 		log.WithFields(logrus.Fields{
@@ -479,11 +508,17 @@ func launch(state *internalState, task mesos.TaskInfo) {
 			"id": task.TaskID.Value,
 		}).
 		Debug("starting gRPC client")
-	state.rpcClients[task.TaskID] = executorcmd.NewClient(commandInfo.ControlPort, commandInfo.ControlMode)
-	state.rpcClients[task.TaskID].TaskCmd = taskCmd
+	rpcClient := executorcmd.NewClient(commandInfo.ControlPort, commandInfo.ControlMode)
+	rpcClient.TaskCmd = taskCmd
+	state.rpcClients[task.TaskID] = rpcClient
 	state.mu.Unlock()
 
+	// taskCtx lives as long as the task process, and is used for streams and reconnection attempts
+	taskCtx, cancelTaskCtx := context.WithCancel(context.Background())
+
 	go func() {
+		defer cancelTaskCtx()
+
 		elapsed := 0 * time.Second
 		for {
 			log.WithFields(logrus.Fields{
@@ -544,7 +579,7 @@ func launch(state *internalState, task mesos.TaskInfo) {
 
 		// Set up event stream from task
 		state.mu.RLock()
-		esc, err := state.rpcClients[task.TaskID].EventStream(context.TODO(), &pb.EventStreamRequest{}, grpc.EmptyCallOption{})
+		esc, err := state.rpcClients[task.TaskID].EventStream(taskCtx, &pb.EventStreamRequest{}, grpc.EmptyCallOption{})
 		state.mu.RUnlock()
 		if err != nil {
 			log.WithField("task", task.Name).WithError(err).Error("cannot set up event stream from task")
@@ -559,67 +594,124 @@ func launch(state *internalState, task mesos.TaskInfo) {
 			return
 		}
 
-		log.WithField("task", task.Name).Debug("notifying of task running state")
-		status.State = mesos.TASK_RUNNING.Enum()
-
-		// send RUNNING
-		state.mu.Lock()
-		err = update(state, status)
-		if err != nil {
-			log.WithFields(logrus.Fields{
-					"id":  task.TaskID.Value,
-					"task": task.Name,
-					"error": err.Error(),
-				}).
-				Error("failed to send TASK_RUNNING")
-			status.State = mesos.TASK_FAILED.Enum()
-			status.Message = protoString(err.Error())
+		deo := event.DeviceEventOrigin{
+			AgentId: task.AgentID,
+			ExecutorId: task.GetExecutor().ExecutorID,
+			TaskId: task.TaskID,
+		}
 
-			state.failedTasks[task.TaskID] = status
-			state.rpcClients[task.TaskID].Close()
-			delete(state.rpcClients, task.TaskID)
+		if restartCount == 0 {
+			log.WithField("task", task.Name).Debug("notifying of task running state")
+			status.State = mesos.TASK_RUNNING.Enum()
 
-			if taskCmd.Process != nil {
+			// send RUNNING
+			state.mu.Lock()
+			err = update(state, status)
+			if err != nil {
 				log.WithFields(logrus.Fields{
-						"process": taskCmd.Process.Pid,
-						"id":      task.TaskID.Value,
-						"task":    task.Name,
+						"id":  task.TaskID.Value,
+						"task": task.Name,
+						"error": err.Error(),
 					}).
-					Warning("killing leftover process")
-				err = syscall.Kill(-taskCmd.Process.Pid, syscall.SIGKILL)
-				if err != nil {
+					Error("failed to send TASK_RUNNING")
+				status.State = mesos.TASK_FAILED.Enum()
+				status.Message = protoString(err.Error())
+
+				state.failedTasks[task.TaskID] = status
+				state.rpcClients[task.TaskID].Close()
+				delete(state.rpcClients, task.TaskID)
+
+				if taskCmd.Process != nil {
 					log.WithFields(logrus.Fields{
 							"process": taskCmd.Process.Pid,
 							"id":      task.TaskID.Value,
 							"task":    task.Name,
 						}).
-						Error("cannot kill process")
+						Warning("killing leftover process")
+					err = syscall.Kill(-taskCmd.Process.Pid, syscall.SIGKILL)
+					if err != nil {
+						log.WithFields(logrus.Fields{
+								"process": taskCmd.Process.Pid,
+								"id":      task.TaskID.Value,
+								"task":    task.Name,
+							}).
+							Error("cannot kill process")
+					}
 				}
+				state.mu.Unlock()
+				return
 			}
 			state.mu.Unlock()
-			return
+		} else {
+			// As far as Mesos is concerned the task never stopped RUNNING, we only need to
+			// let the core know that it should push the last configuration again.
+			jsonEvent, err := json.Marshal(event.NewTaskRestarted(deo, restartCount, "STANDBY"))
+			if err != nil {
+				log.WithError(err).Warning("error marshaling restart event for task")
+			} else {
+				state.mu.RLock()
+				state.cli.Send(context.TODO(), calls.NonStreaming(calls.Message(jsonEvent)))
+				state.mu.RUnlock()
+				log.WithFields(logrus.Fields{
+						"task": task.TaskID.Value,
+						"restartCount": restartCount,
+					}).
+					Info("task restarted, notified core")
+			}
 		}
-		state.mu.Unlock()
+
+		// Process state changes from task
+		go watchStateStream(taskCtx, state, task, rpcClient, deo)
 
 		// Process events from task
 		go func() {
-			deo := event.DeviceEventOrigin{
-				AgentId: task.AgentID,
-				ExecutorId: task.GetExecutor().ExecutorID,
-				TaskId: task.TaskID,
-			}
 			for {
-				if _, ok := state.rpcClients[task.TaskID]; !ok {
+				// If the task was restarted, the RPC client in the state belongs to the
+				// new process and has its own event stream.
+				if rc, ok := state.rpcClients[task.TaskID]; !ok || rc != rpcClient {
 					log.WithError(err).Warning("event stream done")
 					break
 				}
 				esr, err := esc.Recv()
-				if err == io.EOF {
-					log.WithError(err).Warning("event stream EOF")
-					break
-				}
+				// io.EOF is handled like any other error: the task closing the stream
+				// while it is still running means the connection must be re-established
 				if err != nil {
-					log.WithError(err).Warning("error receiving event from task")
+					log.WithError(err).WithField("task", task.Name).Warning("error receiving event from task")
+					if taskCtx.Err() != nil {
+						// the process is gone, nothing to reconnect to
+						break
+					}
+
+					// The stream is broken, most likely the connection with the task dropped.
+					// We attempt to redial and, if successful, subscribe to a new event stream.
+					previousState, currentState, reconnectErr := rpcClient.Reconnect(taskCtx)
+					if reconnectErr != nil {
+						log.WithError(reconnectErr).
+							WithField("task", task.Name).
+							Error("cannot reconnect to task, event stream done")
+						break
+					}
+					if previousState != currentState {
+						log.WithFields(logrus.Fields{
+								"task": task.Name,
+								"previousState": previousState,
+								"state": currentState,
+							}).
+							Warning("task state changed while the connection was down")
+						trigger := pb.StateChangeTrigger_DEVICE_INTENTIONAL
+						if rpcClient.FromDeviceState(currentState) == "ERROR" {
+							trigger = pb.StateChangeTrigger_DEVICE_ERROR
+						}
+						sendDeviceStateChanged(state, deo, rpcClient.FromDeviceState(currentState), trigger)
+					}
+					esc, err = rpcClient.EventStream(taskCtx, &pb.EventStreamRequest{}, grpc.EmptyCallOption{})
+					if err != nil {
+						log.WithError(err).
+							WithField("task", task.Name).
+							Error("cannot set up event stream from task after reconnection")
+						break
+					}
+					go watchStateStream(taskCtx, state, task, rpcClient, deo)
 					continue
 				}
 				ev := esr.GetEvent()
@@ -648,10 +740,17 @@ func launch(state *internalState, task mesos.TaskInfo) {
 		}()
 
 		err = taskCmd.Wait()
+		cancelTaskCtx()
+
+		// Deferred before the unlock, so that it runs once the state is unlocked
+		var emptyShmSession string
+		defer func() {
+			cleanupShmSession(emptyShmSession)
+		}()
 
 		state.mu.Lock()
 		defer state.mu.Unlock()
-		if _, ok := state.rpcClients[task.TaskID]; ok {
+		if rc, ok := state.rpcClients[task.TaskID]; ok && rc == rpcClient {
 			state.rpcClients[task.TaskID].Close() // NOTE: might return non-nil error, but we don't care much
 			log.Debug("rpc client closed")
 			delete(state.rpcClients, task.TaskID)
@@ -665,11 +764,52 @@ func launch(state *internalState, task mesos.TaskInfo) {
 					"error": err.Error(),
 				}).
 				Error("process terminated with error")
+
+			if commandInfo.Restart.ShouldRestart(restartCount) {
+				backoff := commandInfo.Restart.GetBackoff(restartCount)
+				log.WithFields(logrus.Fields{
+						"id":           task.TaskID.Value,
+						"task":         task.Name,
+						"restartCount": restartCount + 1,
+						"maxRetries":   commandInfo.Restart.MaxRetries,
+						"backoff":      backoff.String(),
+					}).
+					Warning("relaunching task according to restart policy")
+				state.restartingTasks[task.TaskID] = restartCount + 1
+
+				go func() {
+					time.Sleep(backoff)
+
+					state.mu.Lock()
+					delete(state.restartingTasks, task.TaskID)
+					// The task might have been killed while we were waiting
+					if killedStatus, ok := state.killedTasks[task.TaskID]; ok {
+						delete(state.killedTasks, task.TaskID)
+						err := update(state, killedStatus)
+						if err != nil {
+							killedStatus.State = mesos.TASK_FAILED.Enum()
+							killedStatus.Message = protoString(err.Error())
+							state.failedTasks[task.TaskID] = killedStatus
+						}
+						state.mu.Unlock()
+						return
+					}
+					newTaskStatus := newStatus(state, task.TaskID)
+					state.mu.Unlock()
+
+					runTask(state, task, commandInfo, newTaskStatus, restartCount + 1)
+				}()
+				return
+			}
+
 			status.State = mesos.TASK_FAILED.Enum()
 			status.Message = protoString(err.Error())
 			state.failedTasks[task.TaskID] = status
+			emptyShmSession = removeShmSessionTask(state, task.TaskID)
 			return
 		}
+		// The task is not coming back, whether it was killed or exited on its own
+		emptyShmSession = removeShmSessionTask(state, task.TaskID)
 
 		if errStdout != nil || errStderr != nil {
 			log.WithFields(logrus.Fields{
@@ -708,10 +848,88 @@ func launch(state *internalState, task mesos.TaskInfo) {
 	log.WithField("task", task.Name).Debug("gRPC client running, handler forked")
 }
 
+// watchStateStream subscribes to the StateStream of a task and forwards to the core all the
+// state changes which weren't triggered by the executor.
+// If the stream breaks, watchStateStream returns: reconnection is handled by the event stream
+// handler, which also starts a new watchStateStream once the connection is back.
+func watchStateStream(ctx context.Context, state *internalState, task mesos.TaskInfo, rpcClient *executorcmd.RpcClient, deo event.DeviceEventOrigin) {
+	ssc, err := rpcClient.StateStream(ctx, &pb.StateStreamRequest{}, grpc.EmptyCallOption{})
+	if err != nil {
+		log.WithError(err).WithField("task", task.Name).Warning("cannot set up state stream from task")
+		return
+	}
+
+	for {
+		ssr, err := ssc.Recv()
+		if err == io.EOF {
+			log.WithField("task", task.Name).Debug("state stream EOF")
+			return
+		}
+		if err != nil {
+			log.WithError(err).WithField("task", task.Name).Debug("state stream done")
+			return
+		}
+		if ssr.GetType() != pb.StateType_STATE_STABLE {
+			continue
+		}
+
+		// Intermediate states which the transitioner doesn't know about are ignored
+		reachedState := rpcClient.FromDeviceState(ssr.GetState())
+		if len(reachedState) == 0 {
+			continue
+		}
+
+		trigger := rpcClient.TriggerForStateChange(ssr.GetState())
+		if trigger == pb.StateChangeTrigger_EXECUTOR {
+			continue
+		}
+
+		log.WithFields(logrus.Fields{
+				"task": task.Name,
+				"deviceState": ssr.GetState(),
+				"state": reachedState,
+				"trigger": trigger.String(),
+			}).
+			Warning("task changed state on its own")
+		sendDeviceStateChanged(state, deo, reachedState, trigger)
+	}
+}
+
+// sendDeviceStateChanged notifies the core of a state change not requested by the core itself.
+func sendDeviceStateChanged(state *internalState, deo event.DeviceEventOrigin, reachedState string, trigger pb.StateChangeTrigger) {
+	jsonEvent, err := json.Marshal(event.NewDeviceStateChanged(deo, reachedState, trigger))
+	if err != nil {
+		log.WithError(err).Warning("error marshaling state change from task")
+		return
+	}
+
+	state.mu.RLock()
+	defer state.mu.RUnlock()
+	state.cli.Send(context.TODO(), calls.NonStreaming(calls.Message(jsonEvent)))
+	log.WithFields(logrus.Fields{
+			"task": deo.TaskId.Value,
+			"event": string(jsonEvent),
+		}).
+		Debug("state change sent")
+}
+
 func kill(state *internalState, e *executor.Event_Kill) error {
 	state.mu.RLock()
 	rpcClient, ok := state.rpcClients[e.GetTaskID()]
 	if !ok {
+		if _, restarting := state.restartingTasks[e.GetTaskID()]; restarting {
+			state.mu.RUnlock()
+			// The process is gone and waiting to be relaunched, we only need to
+			// make sure the relaunch doesn't happen.
+			state.mu.Lock()
+			status := newStatus(state, e.GetTaskID())
+			status.State = mesos.TASK_KILLED.Enum()
+			state.killedTasks[e.GetTaskID()] = status
+			emptyShmSession := removeShmSessionTask(state, e.GetTaskID())
+			state.mu.Unlock()
+			cleanupShmSession(emptyShmSession)
+			return nil
+		}
 		state.mu.RUnlock()
 		return errors.New("invalid task ID")
 	}
@@ -791,11 +1009,16 @@ func kill(state *internalState, e *executor.Event_Kill) error {
 
 	status := newStatus(state, e.GetTaskID())
 
-	// When killing we must always use syscall.Kill with a negative PID, in order to kill all
-	// children which were assigned the same PGID at launch
-	killErr := syscall.Kill(-rpcClient.TaskCmd.Process.Pid, syscall.SIGKILL)
-	if killErr != nil {
-		log.WithError(killErr).WithField("taskId", e.GetTaskID()).Warning("could not kill task")
+	if bt, ok := state.basicTasks[e.GetTaskID()]; ok {
+		// A basic task might not have a process at all, we let it clean up after itself
+		bt.end()
+	} else {
+		// When killing we must always use syscall.Kill with a negative PID, in order to kill all
+		// children which were assigned the same PGID at launch
+		killErr := syscall.Kill(-rpcClient.TaskCmd.Process.Pid, syscall.SIGKILL)
+		if killErr != nil {
+			log.WithError(killErr).WithField("taskId", e.GetTaskID()).Warning("could not kill task")
+		}
 	}
 
 	if reachedState == "DONE" {
@@ -806,9 +1029,11 @@ func kill(state *internalState, e *executor.Event_Kill) error {
 		status.State = mesos.TASK_KILLED.Enum()
 	}
 	state.killedTasks[e.GetTaskID()] = status
+	emptyShmSession := removeShmSessionTask(state, e.GetTaskID())
 
 	log.Debug("unlocking state")
 	state.mu.Unlock()
+	cleanupShmSession(emptyShmSession)
 	return err
 }
 
@@ -860,5 +1085,8 @@ type internalState struct {
 	failedTasks    map[mesos.TaskID]mesos.TaskStatus // send updates for these as we can
 	killedTasks    map[mesos.TaskID]mesos.TaskStatus
 	rpcClients     map[mesos.TaskID]*executorcmd.RpcClient
+	restartingTasks map[mesos.TaskID]uint // tasks waiting for relaunch, with their restart count
+	basicTasks     map[mesos.TaskID]*basicTask
+	shmSessions    map[string]map[mesos.TaskID]struct{} // FairMQ shared memory sessions, with the tasks in them
 	shouldQuit     bool
 }
@@ -42,28 +42,48 @@ import (
 	"errors"
 	"encoding/json"
 	"strconv"
+	"github.com/AliceO2Group/Control/common"
 	"github.com/AliceO2Group/Control/common/controlmode"
+	"sync"
 )
 
 var log = logger.New(logrus.StandardLogger(), "executorcmd")
 
+const (
+	dialTimeout          = 20 * time.Second
+	redialAttemptTimeout = 2 * time.Second
+	redialBackoffMin     = 500 * time.Millisecond
+	redialBackoffMax     = 10 * time.Second
+	reconnectTimeout     = 2 * time.Minute
+	// state changes reported this soon after a transition was committed are still
+	// considered its consequence
+	transitionGracePeriod = 1 * time.Second
+)
+
+var (
+	ErrReconnecting = errors.New("gRPC connection to task lost, reconnection in progress")
+	ErrClientClosed = errors.New("gRPC client closed")
+	ErrNoOccEndpoint = errors.New("task has no OCC endpoint in basic control mode")
+)
+
 func NewClient(controlPort uint64, controlMode controlmode.ControlMode) *RpcClient {
 	endpoint := fmt.Sprintf("127.0.0.1:%d", controlPort)
 	log.WithField("endpoint", endpoint).Debug("starting new gRPC client")
 
-	cxt, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	cxt, cancel := context.WithTimeout(context.Background(), dialTimeout)
+	defer cancel()
 	conn, err := grpc.DialContext(cxt, endpoint, grpc.WithInsecure())
 	if err != nil {
 		log.WithField("error", err.Error()).
 			WithField("endpoint", endpoint).
 			Errorf("gRPC client can't dial")
-		cancel()
 		return nil
 	}
 
 	client := &RpcClient {
-		OccClient: pb.NewOccClient(conn),
+		occClient: pb.NewOccClient(conn),
 		conn: conn,
+		endpoint: endpoint,
 	}
 
 	log.WithFields(logrus.Fields{"endpoint": endpoint, "controlMode": controlMode.String()}).Debug("instantiating new transitioner")
@@ -71,17 +91,283 @@ func NewClient(controlPort uint64, controlMode controlmode.ControlMode) *RpcClie
 	return client
 }
 
+// NewBasicClient returns an RpcClient for a task in BASIC control mode, which has no OCC
+// endpoint. All transitions go through a transitioner.Basic, which calls transitionFunc
+// whenever the process needs to be acted upon, and GetState is answered locally.
+func NewBasicClient(transitionFunc transitioner.DoTransitionFunc) *RpcClient {
+	log.WithField("controlMode", "basic").Debug("instantiating new transitioner")
+	return &RpcClient{
+		ctrl: transitioner.NewBasicTransitioner(transitionFunc),
+	}
+}
+
+// NewHookClient returns an RpcClient for a task in HOOK control mode, which has no OCC
+// endpoint. All transitions go through a transitioner.Hook, which runs the hook command
+// defined for each transition event.
+func NewHookClient(hooks *common.HookInfo, env []string) *RpcClient {
+	log.WithField("controlMode", "hook").Debug("instantiating new transitioner")
+	return &RpcClient{
+		ctrl: transitioner.NewHookTransitioner(hooks, env),
+	}
+}
+
+// RpcClient wraps the OCC gRPC client for a single task. If the connection breaks, it can
+// be re-established with Reconnect, and while that happens all calls fail immediately with
+// ErrReconnecting.
 type RpcClient struct {
-	pb.OccClient
-	conn *grpc.ClientConn
+	mu           sync.RWMutex
+	occClient    pb.OccClient
+	conn         *grpc.ClientConn
+	endpoint     string
+	reconnecting bool
+	closed       bool
+	knownState   string
+
+	commitsInFlight int
+	lastCommitEnd   time.Time
+
 	ctrl transitioner.Transitioner
 	TaskCmd *exec.Cmd
 }
 
 func (r *RpcClient) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	if r.conn == nil {
+		return nil
+	}
 	return r.conn.Close()
 }
 
+// getOccClient returns the current OCC client, or an error if the connection is
+// being re-established or the client was closed.
+func (r *RpcClient) getOccClient() (client pb.OccClient, err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if r.closed {
+		return nil, ErrClientClosed
+	}
+	if r.reconnecting {
+		return nil, ErrReconnecting
+	}
+	if r.occClient == nil {
+		return nil, ErrNoOccEndpoint
+	}
+	return r.occClient, nil
+}
+
+func (r *RpcClient) EventStream(ctx context.Context, in *pb.EventStreamRequest, opts ...grpc.CallOption) (pb.Occ_EventStreamClient, error) {
+	client, err := r.getOccClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.EventStream(ctx, in, opts...)
+}
+
+func (r *RpcClient) StateStream(ctx context.Context, in *pb.StateStreamRequest, opts ...grpc.CallOption) (pb.Occ_StateStreamClient, error) {
+	client, err := r.getOccClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.StateStream(ctx, in, opts...)
+}
+
+func (r *RpcClient) GetState(ctx context.Context, in *pb.GetStateRequest, opts ...grpc.CallOption) (*pb.GetStateReply, error) {
+	// In basic and hook control modes there is no device to ask, the transitioner knows the state
+	if local, ok := r.ctrl.(transitioner.LocalStateTransitioner); ok {
+		return &pb.GetStateReply{State: local.GetState()}, nil
+	}
+
+	client, err := r.getOccClient()
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.GetState(ctx, in, opts...)
+	if err == nil {
+		r.setKnownState(response.GetState())
+	}
+	return response, err
+}
+
+func (r *RpcClient) Transition(ctx context.Context, in *pb.TransitionRequest, opts ...grpc.CallOption) (*pb.TransitionReply, error) {
+	client, err := r.getOccClient()
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.Transition(ctx, in, opts...)
+	if err == nil && response != nil {
+		r.setKnownState(response.GetState())
+	}
+	return response, err
+}
+
+func (r *RpcClient) setKnownState(state string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.knownState = state
+}
+
+// commit performs a full transition through the transitioner, and keeps track of it so that
+// state changes observed in the meantime on the StateStream aren't mistaken for spontaneous ones.
+func (r *RpcClient) commit(evt string, src string, dst string, args map[string]string) (finalState string, err error) {
+	r.mu.Lock()
+	r.commitsInFlight++
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		r.commitsInFlight--
+		r.lastCommitEnd = time.Now()
+		r.mu.Unlock()
+	}()
+
+	return r.ctrl.Commit(evt, src, dst, args)
+}
+
+// TriggerForStateChange tells whether a device state reported by the task on its StateStream
+// was reached because of a transition requested by the executor, or because the device moved
+// on its own. Since the StateStream doesn't carry this information, a change is considered to
+// be triggered by the device if no transition is being committed by the executor (allowing for
+// a short grace period for late stream messages) and it doesn't match the last known state.
+// If the change is spontaneous, the newly reported state also becomes the last known state.
+func (r *RpcClient) TriggerForStateChange(deviceState string) pb.StateChangeTrigger {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.commitsInFlight > 0 ||
+		time.Since(r.lastCommitEnd) < transitionGracePeriod ||
+		deviceState == r.knownState {
+		return pb.StateChangeTrigger_EXECUTOR
+	}
+
+	r.knownState = deviceState
+	if r.ctrl.FromDeviceState(deviceState) == "ERROR" {
+		return pb.StateChangeTrigger_DEVICE_ERROR
+	}
+	return pb.StateChangeTrigger_DEVICE_INTENTIONAL
+}
+
+// SetLocalState overrides the state of a task in basic or hook control mode, for instance if
+// its process exited on its own. It has no effect on tasks controlled through OCC.
+func (r *RpcClient) SetLocalState(state string) {
+	if local, ok := r.ctrl.(transitioner.LocalStateTransitioner); ok {
+		local.SetState(state)
+	}
+}
+
+// IsReconnecting returns true while Reconnect is in progress.
+func (r *RpcClient) IsReconnecting() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.reconnecting
+}
+
+// Reconnect drops the current connection to the task and redials it with exponential
+// backoff, until it succeeds, ctx is done or reconnectTimeout expires.
+// Once a new connection is up, the device state is reconciled with GetState, and both the
+// last state known before the connection was lost and the current one are returned (as
+// device states, not transitioner states).
+// The caller is responsible for setting up again any stream it had open on the old connection.
+func (r *RpcClient) Reconnect(ctx context.Context) (previousState string, currentState string, err error) {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		err = ErrClientClosed
+		return
+	}
+	if r.reconnecting {
+		r.mu.Unlock()
+		err = ErrReconnecting
+		return
+	}
+	if r.conn == nil {
+		r.mu.Unlock()
+		err = ErrNoOccEndpoint
+		return
+	}
+	r.reconnecting = true
+	previousState = r.knownState
+	_ = r.conn.Close() // NOTE: might return non-nil error, but the connection is broken anyway
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		r.reconnecting = false
+		r.mu.Unlock()
+	}()
+
+	log.WithField("endpoint", r.endpoint).Warning("gRPC connection to task lost, reconnecting")
+
+	backoff := redialBackoffMin
+	deadline := time.Now().Add(reconnectTimeout)
+	for attempt := 1; ; attempt++ {
+		var (
+			conn *grpc.ClientConn
+			response *pb.GetStateReply
+		)
+		attemptCtx, cancel := context.WithTimeout(ctx, redialAttemptTimeout)
+		conn, err = grpc.DialContext(attemptCtx, r.endpoint, grpc.WithInsecure(), grpc.WithBlock())
+		if err == nil {
+			client := pb.NewOccClient(conn)
+			response, err = client.GetState(attemptCtx, &pb.GetStateRequest{}, grpc.EmptyCallOption{})
+			if err == nil {
+				cancel()
+				currentState = response.GetState()
+
+				r.mu.Lock()
+				if r.closed {
+					// Close was called while we were reconnecting, we give up
+					r.mu.Unlock()
+					_ = conn.Close()
+					err = ErrClientClosed
+					return
+				}
+				r.conn = conn
+				r.occClient = client
+				r.knownState = currentState
+				r.mu.Unlock()
+
+				log.WithFields(logrus.Fields{
+						"endpoint": r.endpoint,
+						"attempts": attempt,
+						"previousState": previousState,
+						"state": currentState,
+					}).
+					Info("gRPC connection to task re-established")
+				return
+			}
+			_ = conn.Close()
+		}
+		cancel()
+
+		log.WithFields(logrus.Fields{
+				"endpoint": r.endpoint,
+				"attempt": attempt,
+				"error": err.Error(),
+			}).
+			Debug("cannot reconnect to task yet")
+
+		if ctx.Err() != nil {
+			err = fmt.Errorf("reconnection to task at %s aborted: %s", r.endpoint, ctx.Err().Error())
+			return
+		}
+		if time.Now().After(deadline) {
+			err = fmt.Errorf("cannot reconnect to task at %s after %d attempts: %s", r.endpoint, attempt, err.Error())
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > redialBackoffMax {
+			backoff = redialBackoffMax
+		}
+	}
+}
+
 func (r *RpcClient) FromDeviceState(state string) string {
 	return r.ctrl.FromDeviceState(state)
 }
@@ -116,6 +402,13 @@ func (r *RpcClient) doTransition(ei transitioner.EventInfo) (newState string, er
 
 	log.Debug("response received, about to parse status")
 
+	if err == ErrReconnecting || err == ErrClientClosed {
+		// The call never reached the task, so there's no gRPC status to parse
+		err = fmt.Errorf("cannot perform transition %s: %s", ei.Evt, err.Error())
+		log.WithField("event", ei.Evt).WithError(err).Error("transition call error")
+		return
+	}
+
 	if err != nil {
 		// We must process the error explicitly here, otherwise we get an error because gRPC's
 		// Status is different from what gogoproto expects.
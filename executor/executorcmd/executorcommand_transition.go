@@ -46,5 +46,5 @@ func (e *ExecutorCommand_Transition) PrepareResponse(err error, currentState str
 }
 
 func (e *ExecutorCommand_Transition) Commit() (finalState string, err error) {
-	return e.rc.ctrl.Commit(e.Event, e.Source, e.Destination, e.Arguments)
+	return e.rc.commit(e.Event, e.Source, e.Destination, e.Arguments)
 }
@@ -0,0 +1,98 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package transitioner
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Basic is the transitioner for processes without a state machine and without an OCC
+// endpoint. The task is in STANDBY as soon as the executor launches it, CONFIGURE and RESET
+// are no-ops, while START, STOP and EXIT are passed on to DoTransition, which is expected
+// to start, signal and clean up the process respectively.
+// Since there's no device to query, Basic also keeps track of the current state.
+type Basic struct {
+	DoTransition DoTransitionFunc
+
+	commitMu sync.Mutex   // serializes transitions, held while DoTransition runs
+	mu       sync.RWMutex // guards state only, so that GetState never waits for a transition
+	state    string
+}
+
+func NewBasicTransitioner(transitionFunc DoTransitionFunc) *Basic {
+	return &Basic{
+		DoTransition: transitionFunc,
+		state: "STANDBY",
+	}
+}
+
+func (cm *Basic) Commit(evt string, src string, dst string, args map[string]string) (finalState string, err error) {
+	cm.commitMu.Lock()
+	defer cm.commitMu.Unlock()
+
+	currentState := cm.GetState()
+	if src != currentState {
+		err = fmt.Errorf("cannot perform transition %s from %s, current state is %s", evt, src, currentState)
+		finalState = currentState
+		return
+	}
+
+	switch evt {
+	case "CONFIGURE", "RESET", "RECOVER":
+		finalState = dst
+	case "START", "STOP", "EXIT":
+		finalState, err = cm.DoTransition(EventInfo{evt, src, dst, args})
+	case "GO_ERROR":
+		finalState = "ERROR"
+	default:
+		log.WithField("event", evt).Error("transition impossible")
+		err = fmt.Errorf("transition %s impossible in basic control mode", evt)
+		finalState = src
+	}
+
+	if len(finalState) != 0 {
+		cm.SetState(finalState)
+	}
+	return
+}
+
+func (cm *Basic) FromDeviceState(state string) string {
+	return state
+}
+
+// GetState returns the state reached by the last transition, or set with SetState.
+func (cm *Basic) GetState() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.state
+}
+
+// SetState overrides the current state, for instance if the process exited on its own.
+func (cm *Basic) SetState(state string) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.state = state
+}
@@ -0,0 +1,206 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package transitioner
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/AliceO2Group/Control/common"
+	"github.com/sirupsen/logrus"
+)
+
+var envVarNameRe = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// Hook is the transitioner for processes controlled by running a command on each transition,
+// such as legacy configure.sh/start.sh scripts.
+// The command for a transition event is run with /bin/sh -c, with the transition arguments
+// as environment variables. If it exits with status 0 within the timeout, the destination
+// state is reached. If it fails, the task stays in the source state, and if it times out,
+// its whole process group is killed and the task goes to ERROR.
+// A transition event without a command always succeeds.
+type Hook struct {
+	Hooks *common.HookInfo
+	Env   []string
+
+	commitMu sync.Mutex   // serializes transitions, held while a hook runs
+	mu       sync.RWMutex // guards state only, so that GetState never waits for a hook
+	state    string
+}
+
+func NewHookTransitioner(hooks *common.HookInfo, env []string) *Hook {
+	if hooks == nil {
+		hooks = &common.HookInfo{}
+	}
+	return &Hook{
+		Hooks: hooks,
+		Env: env,
+		state: "STANDBY",
+	}
+}
+
+func (cm *Hook) Commit(evt string, src string, dst string, args map[string]string) (finalState string, err error) {
+	cm.commitMu.Lock()
+	defer cm.commitMu.Unlock()
+
+	currentState := cm.GetState()
+	if src != currentState {
+		err = fmt.Errorf("cannot perform transition %s from %s, current state is %s", evt, src, currentState)
+		finalState = currentState
+		return
+	}
+
+	if evt == "GO_ERROR" {
+		dst = "ERROR"
+	}
+
+	command, ok := cm.Hooks.Commands[evt]
+	if !ok || len(command) == 0 {
+		log.WithField("event", evt).Debug("no hook for transition, nothing to do")
+		finalState = dst
+		cm.SetState(finalState)
+		return
+	}
+
+	finalState, err = cm.runHook(command, EventInfo{evt, src, dst, args})
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	// The state may have been overridden with SetState while the hook ran,
+	// in which case it wins over the outcome of the hook
+	if cm.state != src {
+		if err == nil {
+			err = fmt.Errorf("state changed to %s while running hook for transition %s", cm.state, evt)
+		}
+		finalState = cm.state
+		return
+	}
+	cm.state = finalState
+	return
+}
+
+func (cm *Hook) runHook(command string, ei EventInfo) (finalState string, err error) {
+	hookCmd := exec.Command("/bin/sh", "-c", command)
+	hookCmd.Env = append(os.Environ(), cm.Env...)
+	hookCmd.Env = append(hookCmd.Env,
+		"O2_TRANSITION_EVENT=" + ei.Evt,
+		"O2_TRANSITION_SRC=" + ei.Src,
+		"O2_TRANSITION_DST=" + ei.Dst)
+	for k, v := range ei.Args {
+		hookCmd.Env = append(hookCmd.Env, envVarNameRe.ReplaceAllString(k, "_") + "=" + v)
+	}
+	// We run the hook in its own process group, so that on timeout we can kill
+	// whatever the script might have spawned
+	hookCmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+
+	var output bytes.Buffer
+	hookCmd.Stdout = &output
+	hookCmd.Stderr = &output
+
+	log.WithFields(logrus.Fields{
+			"event": ei.Evt,
+			"command": command,
+			"timeout": cm.Hooks.Timeout.String(),
+		}).
+		Debug("running transition hook")
+
+	err = hookCmd.Start()
+	if err != nil {
+		finalState = ei.Src
+		err = fmt.Errorf("cannot run hook for transition %s: %s", ei.Evt, err.Error())
+		return
+	}
+
+	exited := make(chan error, 1)
+	go func() {
+		exited <- hookCmd.Wait()
+	}()
+
+	var timeout <-chan time.Time
+	if cm.Hooks.Timeout > 0 {
+		timeout = time.After(cm.Hooks.Timeout)
+	}
+
+	select {
+	case err = <-exited:
+	case <-timeout:
+		_ = syscall.Kill(-hookCmd.Process.Pid, syscall.SIGKILL)
+		<-exited
+		log.WithFields(logrus.Fields{
+				"event": ei.Evt,
+				"command": command,
+				"output": output.String(),
+			}).
+			Error("transition hook timed out")
+		finalState = "ERROR"
+		err = fmt.Errorf("hook for transition %s timed out after %s", ei.Evt, cm.Hooks.Timeout.String())
+		return
+	}
+
+	if err != nil {
+		log.WithFields(logrus.Fields{
+				"event": ei.Evt,
+				"command": command,
+				"output": output.String(),
+				"error": err.Error(),
+			}).
+			Error("transition hook failed")
+		finalState = ei.Src
+		err = fmt.Errorf("hook for transition %s failed: %s", ei.Evt, err.Error())
+		return
+	}
+
+	log.WithFields(logrus.Fields{
+			"event": ei.Evt,
+			"output": output.String(),
+		}).
+		Debug("transition hook done")
+	finalState = ei.Dst
+	return
+}
+
+func (cm *Hook) FromDeviceState(state string) string {
+	return state
+}
+
+// GetState returns the state reached by the last transition, or set with SetState.
+func (cm *Hook) GetState() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.state
+}
+
+// SetState overrides the current state.
+func (cm *Hook) SetState(state string) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.state = state
+}
@@ -52,6 +52,8 @@ func NewTransitioner(cm controlmode.ControlMode, transitionFunc DoTransitionFunc
 	switch cm {
 	case controlmode.FAIRMQ:
 		return NewFairMQTransitioner(transitionFunc)
+	case controlmode.BASIC:
+		return NewBasicTransitioner(transitionFunc)
 	case controlmode.DIRECT: fallthrough
 	default:
 		return NewDirectTransitioner(transitionFunc)
@@ -59,3 +61,11 @@ func NewTransitioner(cm controlmode.ControlMode, transitionFunc DoTransitionFunc
 }
 
 type DoTransitionFunc func(ei EventInfo) (newState string, err error)
+
+// LocalStateTransitioner is implemented by transitioners for processes without an OCC
+// endpoint, which therefore have to keep track of the current state themselves.
+type LocalStateTransitioner interface {
+	Transitioner
+	GetState() string
+	SetState(state string)
+}
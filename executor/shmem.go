@@ -0,0 +1,156 @@
+/*
+ * === This file is part of ALICE O² ===
+ *
+ * Copyright 2019 CERN and copyright holders of ALICE O².
+ * Author: Teo Mrnjavac <teo.mrnjavac@cern.ch>
+ *
+ * This program is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * This program is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU General Public License for more details.
+ *
+ * You should have received a copy of the GNU General Public License
+ * along with this program.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * In applying this license CERN does not waive the privileges and
+ * immunities granted to it by virtue of its status as an
+ * Intergovernmental Organization or submit itself to any jurisdiction.
+ */
+
+package executor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mesos/mesos-go/api/v1/lib"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	shmPath           = "/dev/shm"
+	shmMonitorCmd     = "fairmq-shmmonitor"
+	shmMonitorTimeout = 10 * time.Second
+)
+
+// addShmSessionTask records that a task uses a FairMQ shared memory session.
+// It must be called with the state locked.
+func addShmSessionTask(state *internalState, session string, taskId mesos.TaskID) {
+	if len(session) == 0 {
+		return
+	}
+	if _, ok := state.shmSessions[session]; !ok {
+		state.shmSessions[session] = make(map[mesos.TaskID]struct{})
+	}
+	state.shmSessions[session][taskId] = struct{}{}
+}
+
+// removeShmSessionTask forgets a task which is gone, because it was killed or
+// exited on its own. If it was the last one in its shared memory session, the
+// session is returned, so that the caller can pass it to cleanupShmSession once
+// the state is unlocked.
+// It must be called with the state locked.
+func removeShmSessionTask(state *internalState, taskId mesos.TaskID) (emptySession string) {
+	for session, taskIds := range state.shmSessions {
+		if _, ok := taskIds[taskId]; !ok {
+			continue
+		}
+		delete(taskIds, taskId)
+		if len(taskIds) == 0 {
+			delete(state.shmSessions, session)
+			return session
+		}
+		return
+	}
+	return
+}
+
+// cleanupShmSession removes the shared memory segments, management data and
+// semaphores of a FairMQ session. These are normally removed by the last
+// device to leave the session, but they stay around if the devices crash or
+// get killed.
+// We let FairMQ's own fairmq-shmmonitor do it if it is installed, otherwise
+// we remove the files it would, see removeShmSessionFiles.
+func cleanupShmSession(session string) {
+	if len(session) == 0 {
+		return
+	}
+
+	monitorPath, err := exec.LookPath(shmMonitorCmd)
+	if err == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), shmMonitorTimeout)
+		defer cancel()
+		output, err := exec.CommandContext(ctx, monitorPath, "--cleanup", "--session", session).CombinedOutput()
+		if err == nil {
+			log.WithField("session", session).Debug("shared memory session cleaned up")
+			return
+		}
+		log.WithError(err).
+			WithFields(logrus.Fields{
+				"session": session,
+				"output":  strings.TrimSpace(string(output)),
+			}).
+			Warning("cannot clean up shared memory session with " + shmMonitorCmd)
+	}
+	removeShmSessionFiles(session)
+}
+
+// shmId returns the ID FairMQ derives from a session ID to name the shared
+// memory objects of the session (fairmq/shmem/Common.h, makeShmIdStr): the
+// first 4 bytes of the SHA-256 of the effective user ID followed by the
+// session ID, in hex.
+func shmId(session string) string {
+	seed := sha256.Sum256([]byte(strconv.Itoa(os.Geteuid()) + session))
+	return hex.EncodeToString(seed[:4])
+}
+
+// removeShmSessionFiles removes the objects of a session from /dev/shm, i.e.
+// the segments, management data and semaphores whose names start with
+// fmq_<shmId>_. FairMQ versions before the shmId was introduced used the
+// session ID itself, we look for both.
+func removeShmSessionFiles(session string) {
+	prefixes := []string{"fmq_" + shmId(session) + "_", "fmq_" + session + "_"}
+
+	entries, err := ioutil.ReadDir(shmPath)
+	if err != nil {
+		log.WithError(err).WithField("session", session).Warning("cannot list shared memory segments")
+		return
+	}
+	for _, entry := range entries {
+		name := strings.TrimPrefix(entry.Name(), "sem.")
+		for _, prefix := range prefixes {
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			err = os.Remove(filepath.Join(shmPath, entry.Name()))
+			if err != nil {
+				log.WithError(err).
+					WithFields(logrus.Fields{
+						"session": session,
+						"segment": entry.Name(),
+					}).
+					Warning("cannot remove leftover shared memory segment")
+			} else {
+				log.WithFields(logrus.Fields{
+						"session": session,
+						"segment": entry.Name(),
+					}).
+					Debug("leftover shared memory segment removed")
+			}
+			break
+		}
+	}
+}